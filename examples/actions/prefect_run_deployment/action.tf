@@ -0,0 +1,40 @@
+resource "prefect_flow" "flow" {
+  name = "my-flow"
+}
+
+resource "prefect_deployment" "deployment" {
+  name           = "my-deployment"
+  flow_id        = prefect_flow.flow.id
+  version        = "1.2.0"
+  work_pool_name = "my-work-pool"
+  entrypoint     = "flows/hello.py:hello"
+
+  # Trigger a smoke-test run every time a new version is rolled out.
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.prefect_run_deployment.smoke_test]
+    }
+  }
+}
+
+action "prefect_run_deployment" "smoke_test" {
+  config {
+    deployment_id = prefect_deployment.deployment.id
+    parameters = jsonencode({
+      "name" : "smoke-test"
+    })
+    job_variables = jsonencode({
+      "env" : { "SMOKE_TEST" : "true" }
+    })
+    tags            = ["smoke-test"]
+    idempotency_key = "smoke-test-${prefect_deployment.deployment.version}"
+
+    # Fail the apply if the run ends up FAILED or CRASHED.
+    wait_for_completion = true
+    timeout             = "15m"
+  }
+}
+
+# Actions can also be invoked on demand:
+# terraform apply -invoke=action.prefect_run_deployment.smoke_test
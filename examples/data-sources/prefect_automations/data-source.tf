@@ -0,0 +1,32 @@
+# Query all Automations in the Workspace
+data "prefect_automations" "all" {}
+
+# Query all disabled Automations
+data "prefect_automations" "disabled" {
+  enabled = false
+}
+
+# Query the Automations that target a Deployment,
+# for example to audit them before deleting the Deployment
+data "prefect_automations" "nightly_etl" {
+  related_resource_id = "prefect.deployment.${prefect_deployment.nightly_etl.id}"
+}
+
+# Find Automations whose actions use a Block that no longer exists
+data "prefect_blocks" "webhooks" {
+  type_slug = "webhook"
+}
+
+locals {
+  existing_block_ids = toset([for b in data.prefect_blocks.webhooks.blocks : b.id])
+}
+
+output "automations_with_dangling_blocks" {
+  value = [
+    for a in data.prefect_automations.all.automations : a.name
+    if anytrue([
+      for action in concat(a.actions, a.actions_on_trigger, a.actions_on_resolve) :
+      action.block_document_id != null && !contains(local.existing_block_ids, action.block_document_id)
+    ])
+  ]
+}
@@ -0,0 +1,8 @@
+# Get all Block Schemas of a Block Type
+data "prefect_block_schemas" "aws_credentials" {
+  type_slug = "aws-credentials"
+}
+
+output "aws_credentials_schema_versions" {
+  value = [for s in data.prefect_block_schemas.aws_credentials.block_schemas : s.version]
+}
@@ -0,0 +1,4 @@
+# Get a Block Type by its slug
+data "prefect_block_type" "aws_credentials" {
+  slug = "aws-credentials"
+}
@@ -0,0 +1,25 @@
+# Get all Blocks of a Block Type
+data "prefect_blocks" "aws_credentials" {
+  type_slug = "aws-credentials"
+}
+
+# Get all Blocks of a Block Type whose name starts with a prefix
+data "prefect_blocks" "production_secrets" {
+  type_slug   = "secret"
+  name_prefix = "prod-"
+}
+
+# Example: grant a Team view access to every AWS credentials Block
+data "prefect_team" "platform" {
+  name = "platform"
+}
+
+resource "prefect_block_access" "aws_credentials" {
+  for_each = { for b in data.prefect_blocks.aws_credentials.blocks : b.name => b.id }
+
+  block_id         = each.value
+  manage_actor_ids = []
+  view_actor_ids   = []
+  manage_team_ids  = []
+  view_team_ids    = [data.prefect_team.platform.id]
+}
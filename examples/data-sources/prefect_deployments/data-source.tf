@@ -0,0 +1,26 @@
+# Query all Deployments in the Workspace
+data "prefect_deployments" "all" {}
+
+# Query Deployments of a single Flow that have all of the given tags
+data "prefect_deployments" "nightly_etl" {
+  flow_name = "etl"
+  tags_all  = ["nightly", "production"]
+}
+
+# Query paused Deployments running on a specific Work Pool
+data "prefect_deployments" "paused_on_pool" {
+  work_pool_name = "kubernetes-pool"
+  paused         = true
+}
+
+# Query Deployments by a partial name match
+data "prefect_deployments" "reports" {
+  name_like = "report"
+}
+
+output "nightly_etl_schedules" {
+  value = {
+    for deployment in data.prefect_deployments.nightly_etl.deployments :
+    deployment.name => [for schedule in deployment.schedules : schedule.cron]
+  }
+}
@@ -0,0 +1,14 @@
+# Get a Flow by ID
+data "prefect_flow" "by_id" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Get a Flow registered by CI (for example, via `prefect deploy`) by name
+data "prefect_flow" "etl" {
+  name = "etl"
+}
+
+# Look up one of the Flow's Deployments by name
+output "nightly_deployment_id" {
+  value = one([for d in data.prefect_flow.etl.deployments : d.id if d.name == "nightly"])
+}
@@ -0,0 +1,18 @@
+# Query all Flows in the Workspace
+data "prefect_flows" "all" {}
+
+# Query Flows whose name starts with a prefix
+data "prefect_flows" "etl" {
+  name_prefix = "etl-"
+}
+
+# Query Flows that have all of the given tags
+data "prefect_flows" "production" {
+  tags = ["production"]
+}
+
+output "production_deployment_ids" {
+  value = flatten([
+    for flow in data.prefect_flows.production.flows : [for d in flow.deployments : d.id]
+  ])
+}
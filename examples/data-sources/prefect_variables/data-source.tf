@@ -0,0 +1,16 @@
+# Query all Variables in the Workspace
+data "prefect_variables" "all" {}
+
+# Query all Variables tagged as feature flags
+data "prefect_variables" "feature_flags" {
+  tags = ["feature-flag"]
+}
+
+# Query Variables whose name contains a value
+data "prefect_variables" "database" {
+  name_like = "database_"
+}
+
+output "feature_flags" {
+  value = { for v in data.prefect_variables.feature_flags.variables : v.name => jsondecode(v.value) }
+}
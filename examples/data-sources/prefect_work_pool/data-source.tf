@@ -1,3 +1,11 @@
 data "prefect_work_pool" "my_pool" {
   name = "my-work-pool"
 }
+
+# Use the work pool status as a readiness gate.
+check "work_pool_ready" {
+  assert {
+    condition     = data.prefect_work_pool.my_pool.status == "READY"
+    error_message = "Work pool ${data.prefect_work_pool.my_pool.name} has no active workers."
+  }
+}
@@ -0,0 +1,43 @@
+# Start from the default base job template of the Kubernetes worker,
+# and adjust it for the needs of the team.
+data "prefect_work_pool_job_template" "kubernetes" {
+  worker_type = "kubernetes"
+
+  # Set new default values for variables.
+  variable_defaults = {
+    image             = jsonencode("my-registry/flows:1.0")
+    image_pull_policy = jsonencode("Always")
+    namespace         = jsonencode("data-platform")
+  }
+
+  # Require each deployment to set these variables.
+  required_variables = ["service_account_name"]
+
+  # Hide variables from deployments, and inline their default
+  # value into the job configuration instead.
+  hidden_variables = ["namespace"]
+
+  # Patch the job configuration with a JSON merge patch (RFC 7396)...
+  job_configuration_merge_patch = jsonencode({
+    labels = {
+      "team" = "data-platform"
+    }
+  })
+
+  # ...and/or with a JSON patch (RFC 6902).
+  job_configuration_json_patch = jsonencode([
+    {
+      op    = "add"
+      path  = "/job_manifest/spec/template/spec/priorityClassName"
+      value = "flows"
+    },
+  ])
+}
+
+resource "prefect_work_pool" "kubernetes" {
+  name = "kubernetes"
+  type = "kubernetes"
+
+  # Use the canonical form, so that plans show line-by-line diffs.
+  base_job_template = data.prefect_work_pool_job_template.kubernetes.canonical_base_job_template
+}
@@ -26,3 +26,9 @@ resource "prefect_work_pool" "process" {
   paused            = false
   base_job_template = data.prefect_worker_metadata.d.base_job_configs.cloud_run_push
 }
+
+# Use the metadata embedded in the provider, for example
+# when the Prefect API cannot serve it.
+data "prefect_worker_metadata" "embedded" {
+  source = "embedded"
+}
@@ -0,0 +1,12 @@
+data "prefect_workers" "online" {
+  work_pool_name = "my-work-pool"
+  status         = "ONLINE"
+}
+
+# Warn when no worker is polling the work pool.
+check "work_pool_has_workers" {
+  assert {
+    condition     = length(data.prefect_workers.online.workers) > 0
+    error_message = "No worker is polling the my-work-pool work pool."
+  }
+}
@@ -0,0 +1,9 @@
+# Read down the catalogue of scopes
+# that can be granted to a Workspace Role
+data "prefect_workspace_scopes" "all" {}
+
+# Use the catalogue to grant every read-only scope
+resource "prefect_workspace_role" "read_only" {
+  name   = "Read Only"
+  scopes = [for scope in data.prefect_workspace_scopes.all.names : scope if startswith(scope, "see_")]
+}
@@ -70,6 +70,16 @@ provider "prefect" {
   endpoint = "https://api.prefect.cloud/api/accounts/<account_id>/workspaces/<workspace_id>"
 }
 
+# Resource operations that wait on Prefect Cloud, such as workspace
+# creation, can be given more time via provider-wide default timeouts.
+# Individual resources can override these in their `timeouts` block.
+provider "prefect" {
+  default_timeouts = {
+    create = "10m"
+    delete = "5m"
+  }
+}
+
 # Finally, in rare occasions, you also have the option
 # to point the provider to a locally running Prefect Server,
 # with a limited set of functionality from the provider.
@@ -0,0 +1,2 @@
+# Prefect Account Invitations can be imported using the invitation's UUID
+terraform import prefect_account_invitation.example 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,42 @@
+data "prefect_account_role" "member" {
+  name = "Member"
+}
+
+data "prefect_workspace_role" "developer" {
+  name = "Developer"
+}
+
+data "prefect_workspace" "production" {
+  handle = "production"
+}
+
+# Invite a user to the account, and grant them
+# a Workspace Role once they accept the invitation.
+resource "prefect_account_invitation" "marvin" {
+  email           = "marvin@prefect.io"
+  account_role_id = data.prefect_account_role.member.id
+
+  workspace_roles = [
+    {
+      workspace_id      = data.prefect_workspace.production.id
+      workspace_role_id = data.prefect_workspace_role.developer.id
+    }
+  ]
+}
+
+output "marvin_invitation_status" {
+  value = prefect_account_invitation.marvin.status
+}
+
+# Once the invitation is accepted, hand the membership over
+# to a `prefect_account_member` resource by importing it by email.
+# The invitation can then be kept or removed from the configuration:
+# neither recreates the invitation nor removes the member.
+import {
+  to = prefect_account_member.marvin
+  id = "marvin@prefect.io"
+}
+
+resource "prefect_account_member" "marvin" {
+  account_role_id = data.prefect_account_role.member.id
+}
@@ -0,0 +1,5 @@
+# Prefect Account Roles can be imported by name in the form `name/my-role-name`
+terraform import prefect_account_role.example name/my-role-name
+
+# or via UUID
+terraform import prefect_account_role.example 00000000-0000-0000-0000-000000000000
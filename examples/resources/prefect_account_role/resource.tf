@@ -0,0 +1,27 @@
+# The system Owner role holds every account permission,
+# so its permissions list the valid permission names.
+data "prefect_account_role" "owner" {
+  name = "Owner"
+}
+
+output "available_account_permissions" {
+  value = data.prefect_account_role.owner.permissions
+}
+
+# Start from the permissions of a default Account Role,
+# and extend them as needed.
+data "prefect_account_role" "member" {
+  name = "Member"
+}
+
+resource "prefect_account_role" "example" {
+  name        = "Custom Member"
+  description = "Members with additional account permissions"
+  permissions = data.prefect_account_role.member.permissions
+}
+
+# Assign the custom role to a service account
+resource "prefect_service_account" "example" {
+  name              = "custom-member-bot"
+  account_role_name = prefect_account_role.example.name
+}
@@ -0,0 +1,18 @@
+# Register the block types and schemas of prefect-aws,
+# equivalent to `prefect block register -m prefect_aws`.
+resource "prefect_block_collection" "aws" {
+  name    = "prefect-aws"
+  version = "0.5.10"
+}
+
+# Blocks of the collection's block types can then be created.
+resource "prefect_block" "aws_credentials" {
+  name      = "aws-credentials"
+  type_slug = "aws-credentials"
+
+  data = jsonencode({
+    "region_name" = "us-east-1"
+  })
+
+  depends_on = [prefect_block_collection.aws]
+}
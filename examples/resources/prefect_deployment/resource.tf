@@ -100,6 +100,28 @@ resource "prefect_deployment" "deployment" {
       }
     }
   ]
+  # Schedules declared here replace any schedules created outside of Terraform.
+  # Do not combine with `prefect_deployment_schedule` resources for the same deployment.
+  schedules = [
+    {
+      slug     = "hourly"
+      interval = 3600
+      timezone = "America/New_York"
+    },
+    {
+      slug   = "nightly"
+      cron   = "0 0 * * *"
+      active = true
+      parameters = jsonencode({
+        "some-parameter" : "nightly-value"
+      })
+    },
+    {
+      slug               = "weekly"
+      rrule              = "FREQ=WEEKLY;BYDAY=MO"
+      max_scheduled_runs = 5
+    }
+  ]
   storage_document_id = prefect_block.test_gh_repository.id
   version             = "v1.1.1"
   work_pool_name      = "some-testing-pool"
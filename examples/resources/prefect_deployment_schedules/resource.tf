@@ -0,0 +1,47 @@
+provider "prefect" {}
+
+data "prefect_workspace" "test" {
+  handle = "my-workspace"
+}
+
+resource "prefect_flow" "test" {
+  name         = "my-flow"
+  workspace_id = data.prefect_workspace.test.id
+  tags         = ["test"]
+}
+
+resource "prefect_deployment" "test" {
+  name         = "my-deployment"
+  workspace_id = data.prefect_workspace.test.id
+  flow_id      = prefect_flow.test.id
+}
+
+# Manages every schedule on the deployment. Schedules created outside of
+# Terraform (for example by `prefect deploy` or the UI) are removed on apply.
+resource "prefect_deployment_schedules" "test" {
+  workspace_id  = data.prefect_workspace.test.id
+  deployment_id = prefect_deployment.test.id
+
+  schedules = {
+    # The map key is used as the schedule slug.
+    "every-30-seconds" = {
+      active      = true
+      timezone    = "America/New_York"
+      interval    = 30
+      anchor_date = "2024-01-01T00:00:00Z"
+    }
+
+    "nightly" = {
+      timezone = "America/New_York"
+      cron     = "0 0 * * *"
+      day_or   = true
+      parameters = jsonencode({
+        env = "prod"
+      })
+    }
+
+    "daily" = {
+      rrule = "FREQ=DAILY;INTERVAL=1"
+    }
+  }
+}
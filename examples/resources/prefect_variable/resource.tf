@@ -2,3 +2,14 @@ resource "prefect_variable" "example" {
   name  = "my_variable_name"
   value = "variable value goes here"
 }
+
+# example:
+# use the write-only `value_wo` attribute to keep
+# sensitive values out of the Terraform plan and state
+resource "prefect_variable" "write_only" {
+  name     = "my_token"
+  value_wo = "token value goes here"
+
+  # provide the version to control when to update the variable value
+  value_wo_version = 1
+}
@@ -0,0 +1,5 @@
+# Prefect Variables can be imported via managed_tag, which imports every variable with the tag
+terraform import prefect_variables.example terraform-managed
+
+# or from a different workspace via managed_tag,workspace_id
+terraform import prefect_variables.example terraform-managed,00000000-0000-0000-0000-000000000000
@@ -0,0 +1,34 @@
+resource "prefect_variables" "example" {
+  variables = {
+    "region" = {
+      value = jsonencode("us-east-1")
+    }
+    "retries" = {
+      value = jsonencode(3)
+      tags  = ["ops"]
+    }
+    "settings" = {
+      value = jsonencode({
+        "debug"   = false
+        "targets" = ["a", "b"]
+      })
+    }
+  }
+}
+
+# example:
+# tag every managed variable, and delete the variables with
+# the tag that are no longer in the map
+resource "prefect_variables" "pruned" {
+  managed_tag = "terraform-managed"
+  prune       = true
+
+  variables = {
+    for name, value in {
+      "api_url"   = "https://api.example.com"
+      "page_size" = 100
+    } : name => {
+      value = jsonencode(value)
+    }
+  }
+}
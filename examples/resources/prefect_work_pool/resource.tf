@@ -53,3 +53,36 @@ resource "prefect_work_pool" "example_with_datasource_prefect_managed" {
   paused            = false
   base_job_template = data.prefect_worker_metadata.d.base_job_configs.prefect_managed
 }
+
+# example: configure the storage used for code bundles and results,
+# so that flows can be submitted to the work pool without a deployment.
+resource "prefect_block" "results" {
+  name      = "flow-results"
+  type_slug = "s3-bucket"
+  data = jsonencode({
+    bucket_name = "my-flow-results"
+  })
+}
+
+resource "prefect_work_pool" "example_with_storage_configuration" {
+  name         = "test-ecs-pool"
+  type         = "ecs"
+  workspace_id = data.prefect_workspace.prd.id
+
+  storage_configuration = {
+    bundle_upload_step = jsonencode({
+      "prefect_aws.experimental.bundles.upload" = {
+        requires                   = "prefect-aws"
+        bucket                     = "my-flow-bundles"
+        aws_credentials_block_name = "my-aws-credentials"
+      }
+    })
+    bundle_execution_step = jsonencode({
+      "prefect_aws.experimental.bundles.execute" = {
+        requires                   = "prefect-aws"
+        aws_credentials_block_name = "my-aws-credentials"
+      }
+    })
+    default_result_storage_block_id = prefect_block.results.id
+  }
+}
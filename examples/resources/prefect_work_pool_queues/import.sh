@@ -0,0 +1,5 @@
+# Prefect Work Pool Queues can be imported via work_pool_name
+terraform import prefect_work_pool_queues.example my-work-pool
+
+# or from a different workspace via work_pool_name,workspace_id
+terraform import prefect_work_pool_queues.example my-work-pool,00000000-0000-0000-0000-000000000000
@@ -0,0 +1,40 @@
+resource "prefect_work_pool" "example" {
+  name         = "my-work-pool"
+  type         = "kubernetes"
+  workspace_id = prefect_workspace.example.id
+}
+
+# Queues are listed from the highest to the lowest priority.
+resource "prefect_work_pool_queues" "example" {
+  work_pool_name = prefect_work_pool.example.name
+  workspace_id   = prefect_workspace.example.id
+
+  queues = [
+    {
+      name        = "critical"
+      description = "Jobs that must run first"
+    },
+    {
+      name              = "batch"
+      concurrency_limit = 5
+    },
+    {
+      name      = "backfills"
+      is_paused = true
+    },
+  ]
+}
+
+# Alternatively, delete the queues that are not in the list, such as those created by workers,
+# and manage the work pool's default queue as the lowest priority queue.
+resource "prefect_work_pool_queues" "authoritative" {
+  work_pool_name          = prefect_work_pool.example.name
+  workspace_id            = prefect_workspace.example.id
+  delete_unmanaged_queues = true
+  manage_default_queue    = true
+
+  queues = [
+    { name = "critical" },
+    { name = "default" },
+  ]
+}
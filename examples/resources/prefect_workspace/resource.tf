@@ -2,3 +2,15 @@ resource "prefect_workspace" "example" {
   name   = "My Workspace"
   handle = "my-workspace"
 }
+
+# Workspace creation can take longer on busy Prefect Cloud tenants.
+# Use the `timeouts` block to wait longer for the workspace to become available.
+resource "prefect_workspace" "example_with_timeouts" {
+  name   = "My Other Workspace"
+  handle = "my-other-workspace"
+
+  timeouts {
+    create = "10m"
+    delete = "5m"
+  }
+}
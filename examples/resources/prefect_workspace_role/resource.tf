@@ -5,3 +5,20 @@ resource "prefect_workspace_role" "example" {
     "see_flows"
   ]
 }
+
+# Extend a default Workspace Role with additional scopes.
+# The full permission set, including the inherited scopes,
+# is exposed in the computed `effective_scopes` attribute.
+data "prefect_workspace_role" "viewer" {
+  name = "Viewer"
+}
+
+resource "prefect_workspace_role" "viewer_plus" {
+  name              = "Viewer Plus"
+  scopes            = ["manage_variables"]
+  inherited_role_id = data.prefect_workspace_role.viewer.id
+}
+
+output "viewer_plus_scopes" {
+  value = prefect_workspace_role.viewer_plus.effective_scopes
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountInvitationsClient is a client for working with account invitations.
+type AccountInvitationsClient interface {
+	Create(ctx context.Context, data AccountInvitationCreate) (*AccountInvitation, error)
+	Get(ctx context.Context, invitationID uuid.UUID) (*AccountInvitation, error)
+	Delete(ctx context.Context, invitationID uuid.UUID) error
+}
+
+// Account invitation statuses, as tracked by the provider.
+const (
+	AccountInvitationStatusPending  = "pending"
+	AccountInvitationStatusAccepted = "accepted"
+	AccountInvitationStatusExpired  = "expired"
+)
+
+// AccountInvitation is a representation of an invitation to join an account.
+type AccountInvitation struct {
+	BaseModel
+	AccountID            uuid.UUID             `json:"account_id"`
+	Email                string                `json:"email"`
+	AccountRoleID        uuid.UUID             `json:"account_role_id"`
+	WorkspaceInvitations []WorkspaceInvitation `json:"workspace_invitations"`
+	Expires              *time.Time            `json:"expires"`
+	AcceptedAt           *time.Time            `json:"accepted_at"`
+}
+
+// WorkspaceInvitation grants a Workspace Role in a Workspace
+// to the invited user once the invitation is accepted.
+type WorkspaceInvitation struct {
+	WorkspaceID     uuid.UUID `json:"workspace_id"`
+	WorkspaceRoleID uuid.UUID `json:"workspace_role_id"`
+}
+
+// AccountInvitationCreate is the payload used when inviting a user to an account.
+type AccountInvitationCreate struct {
+	Email                string                `json:"email"`
+	AccountRoleID        uuid.UUID             `json:"account_role_id"`
+	WorkspaceInvitations []WorkspaceInvitation `json:"workspace_invitations,omitempty"`
+}
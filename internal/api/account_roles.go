@@ -7,22 +7,34 @@ import (
 )
 
 type AccountRolesClient interface {
+	Create(ctx context.Context, data AccountRoleUpsert) (*AccountRole, error)
 	Get(ctx context.Context, roleID uuid.UUID) (*AccountRole, error)
 	List(ctx context.Context, roleNames []string) ([]*AccountRole, error)
+	Update(ctx context.Context, roleID uuid.UUID, data AccountRoleUpsert) error
+	Delete(ctx context.Context, roleID uuid.UUID) error
 }
 
 // AccountRole is a representation of an account role.
 type AccountRole struct {
 	BaseModel
 	Name        string   `json:"name"`
+	Description *string  `json:"description"`
 	Permissions []string `json:"permissions"`
 
 	AccountID    *uuid.UUID `json:"account_id"`
 	IsSystemRole bool       `json:"is_system_role"`
 }
 
+// AccountRoleUpsert defines the request payload
+// when creating or updating an account role.
+type AccountRoleUpsert struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
 // AccountRoleFilter defines the search filter payload
-// when searching for workspace roles by name.
+// when searching for account roles by name.
 // example request payload:
 // {"account_roles": {"name": {"any_": ["test"]}}}.
 type AccountRoleFilter struct {
@@ -9,6 +9,7 @@ import (
 // AutomationsClient is a client for working with automations.
 type AutomationsClient interface {
 	Get(ctx context.Context, id uuid.UUID) (*Automation, error)
+	List(ctx context.Context, filter AutomationFilter) ([]*Automation, error)
 	Create(ctx context.Context, data AutomationUpsert) (*Automation, error)
 	Update(ctx context.Context, id uuid.UUID, data AutomationUpsert) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -33,6 +34,38 @@ type AutomationUpsert struct {
 	ActionsOnResolve []Action `json:"actions_on_resolve"`
 }
 
+// AutomationFilter defines filters when listing automations.
+type AutomationFilter struct {
+	// Names matches automations by their exact name.
+	Names []string
+	// Enabled matches automations by whether they are enabled.
+	Enabled *bool
+	// RelatedResourceID matches automations related to a resource,
+	// for example `prefect.deployment.<deployment-id>`.
+	RelatedResourceID string
+}
+
+// AutomationFilterRequest is the request payload
+// for the POST /automations/filter endpoint.
+// example request payload:
+// {"automations": {"name": {"any_": ["my-automation"]}}, "sort": "NAME_ASC"}.
+type AutomationFilterRequest struct {
+	Automations *AutomationFilterAutomations `json:"automations,omitempty"`
+	Sort        string                       `json:"sort,omitempty"`
+	Limit       *int64                       `json:"limit,omitempty"`
+	Offset      *int64                       `json:"offset,omitempty"`
+}
+
+// AutomationFilterAutomations filters on the attributes of the automations.
+type AutomationFilterAutomations struct {
+	Name *AutomationFilterName `json:"name,omitempty"`
+}
+
+// AutomationFilterName matches automations by their exact name.
+type AutomationFilterName struct {
+	Any []string `json:"any_,omitempty"`
+}
+
 // Trigger defines the triggering conditions on an Automation.
 // On the API, a Trigger is a polymorphic type and can be represented
 // by several schemas based on the `type` attribute.
@@ -9,6 +9,7 @@ import (
 type BlockDocumentClient interface {
 	Get(ctx context.Context, id uuid.UUID) (*BlockDocument, error)
 	GetByName(ctx context.Context, typeSlug, name string) (*BlockDocument, error)
+	List(ctx context.Context, filter BlockDocumentFilter) ([]*BlockDocument, error)
 	Create(ctx context.Context, payload BlockDocumentCreate) (*BlockDocument, error)
 	Update(ctx context.Context, id uuid.UUID, payload BlockDocumentUpdate) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -28,6 +29,22 @@ type BlockDocument struct {
 	BlockTypeID   uuid.UUID `json:"block_type_id"`
 	BlockTypeName *string   `json:"block_type_name"`
 	BlockType     BlockType `json:"block_type"`
+
+	// BlockDocumentReferences maps the fields of the Block document
+	// to the Block documents they reference.
+	BlockDocumentReferences map[string]BlockDocumentReference `json:"block_document_references"`
+}
+
+// BlockDocumentReference is a reference from a field of a Block document to another Block document.
+type BlockDocumentReference struct {
+	BlockDocument BlockDocumentReferenceDocument `json:"block_document"`
+}
+
+// BlockDocumentReferenceDocument is a summary of a referenced Block document.
+type BlockDocumentReferenceDocument struct {
+	ID        uuid.UUID `json:"id"`
+	Name      *string   `json:"name"`
+	BlockType BlockType `json:"block_type"`
 }
 
 type BlockDocumentCreate struct {
@@ -43,6 +60,53 @@ type BlockDocumentUpdate struct {
 	MergeExistingData bool           `json:"merge_existing_data"`
 }
 
+// BlockDocumentFilter defines the search filter payload
+// for the POST /block_documents/filter endpoint.
+// example request payload:
+// {"block_documents": {"name": {"like_": "prod"}}, "block_types": {"slug": {"any_": ["aws-credentials"]}}}.
+type BlockDocumentFilter struct {
+	BlockDocuments *BlockDocumentFilterBlockDocuments `json:"block_documents,omitempty"`
+	BlockTypes     *BlockDocumentFilterBlockTypes     `json:"block_types,omitempty"`
+}
+
+// BlockDocumentFilterBlockDocuments filters on the attributes of the block documents.
+type BlockDocumentFilterBlockDocuments struct {
+	IsAnonymous *BlockDocumentFilterIsAnonymous `json:"is_anonymous,omitempty"`
+	Name        *BlockDocumentFilterName        `json:"name,omitempty"`
+}
+
+// BlockDocumentFilterBlockTypes filters on the block types of the block documents.
+type BlockDocumentFilterBlockTypes struct {
+	Slug *BlockDocumentFilterSlug `json:"slug,omitempty"`
+}
+
+// BlockDocumentFilterIsAnonymous matches block documents by whether they are anonymous.
+type BlockDocumentFilterIsAnonymous struct {
+	Eq *bool `json:"eq_,omitempty"`
+}
+
+// BlockDocumentFilterName matches a name exactly (any_), or by a
+// case-insensitive partial match (like_).
+type BlockDocumentFilterName struct {
+	Any  []string `json:"any_,omitempty"`
+	Like *string  `json:"like_,omitempty"`
+}
+
+// BlockDocumentFilterSlug matches block types by slug.
+type BlockDocumentFilterSlug struct {
+	Any []string `json:"any_,omitempty"`
+}
+
+// BlockDocumentFilterRequest wraps BlockDocumentFilter with pagination parameters
+// for the POST /block_documents/filter endpoint.
+type BlockDocumentFilterRequest struct {
+	BlockDocumentFilter
+	IncludeSecrets bool   `json:"include_secrets"`
+	Sort           string `json:"sort,omitempty"`
+	Limit          *int64 `json:"limit,omitempty"`
+	Offset         *int64 `json:"offset,omitempty"`
+}
+
 // BlockDocumentAccessUpsert is the create/update request payload
 // to modify a block document's current access control levels,
 // meaning it contains the list of actors/teams + their respective access
@@ -8,10 +8,12 @@ import "github.com/google/uuid"
 type PrefectClient interface {
 	// Utility methods on the Client interface
 	GetEndpointHost() string
+	GetDefaultTimeouts() Timeouts
 
 	// API Client Factories - for instantiating a client for each API resource
 	Accounts(accountID uuid.UUID) (AccountsClient, error)
 	Automations(accountID uuid.UUID, workspaceID uuid.UUID) (AutomationsClient, error)
+	AccountInvitations(accountID uuid.UUID) (AccountInvitationsClient, error)
 	AccountMemberships(accountID uuid.UUID) (AccountMembershipsClient, error)
 	AccountRoles(accountID uuid.UUID) (AccountRolesClient, error)
 	BlockDocuments(accountID uuid.UUID, workspaceID uuid.UUID) (BlockDocumentClient, error)
@@ -25,13 +27,16 @@ type PrefectClient interface {
 	TeamAccess(accountID uuid.UUID, teamID uuid.UUID) (TeamAccessClient, error)
 	Teams(accountID uuid.UUID) (TeamsClient, error)
 	Flows(accountID uuid.UUID, workspaceID uuid.UUID) (FlowsClient, error)
+	FlowRuns(accountID uuid.UUID, workspaceID uuid.UUID) (FlowRunsClient, error)
 	TaskRunConcurrencyLimits(accountID uuid.UUID, workspaceID uuid.UUID) (TaskRunConcurrencyLimitsClient, error)
 	Workspaces(accountID uuid.UUID) (WorkspacesClient, error)
 	WorkspaceAccess(accountID uuid.UUID, workspaceID uuid.UUID) (WorkspaceAccessClient, error)
 	WorkspaceRoles(accountID uuid.UUID) (WorkspaceRolesClient, error)
+	WorkspaceScopes() (WorkspaceScopesClient, error)
 	WorkPools(accountID uuid.UUID, workspaceID uuid.UUID) (WorkPoolsClient, error)
 	WorkPoolAccess(accountID uuid.UUID, workspaceID uuid.UUID) (WorkPoolAccessClient, error)
 	WorkQueues(accountID uuid.UUID, workspaceID uuid.UUID, workPoolName string) (WorkQueuesClient, error)
+	Workers(accountID uuid.UUID, workspaceID uuid.UUID, workPoolName string) (WorkersClient, error)
 	Variables(accountID uuid.UUID, workspaceID uuid.UUID) (VariablesClient, error)
 	ServiceAccounts(accountID uuid.UUID) (ServiceAccountsClient, error)
 	Users() (UsersClient, error)
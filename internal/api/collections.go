@@ -7,6 +7,7 @@ import (
 
 type CollectionsClient interface {
 	GetWorkerMetadataViews(ctx context.Context) (WorkerTypeByPackage, error)
+	GetBlockMetadataViews(ctx context.Context) (BlockTypeByPackage, error)
 }
 
 // { "prefect": {...}, "prefect-aws": {...} }.
@@ -23,3 +24,32 @@ type WorkerMetadata struct {
 	Description                 string          `json:"description"`
 	DefaultBaseJobConfiguration json.RawMessage `json:"default_base_job_configuration"`
 }
+
+// { "prefect-aws": { "block_types": {...} } }.
+type BlockTypeByPackage map[string]CollectionBlockMetadata
+
+// CollectionBlockMetadata is the block metadata of a collection.
+type CollectionBlockMetadata struct {
+	// BlockTypes is keyed by block type slug.
+	BlockTypes map[string]CollectionBlockType `json:"block_types"`
+}
+
+// CollectionBlockType is a block type served in a collection's block metadata.
+type CollectionBlockType struct {
+	Name             string                `json:"name"`
+	Slug             string                `json:"slug"`
+	LogoURL          string                `json:"logo_url"`
+	DocumentationURL string                `json:"documentation_url"`
+	Description      string                `json:"description"`
+	CodeExample      string                `json:"code_example"`
+	BlockSchema      CollectionBlockSchema `json:"block_schema"`
+}
+
+// CollectionBlockSchema is the block schema of a block type
+// served in a collection's block metadata.
+type CollectionBlockSchema struct {
+	Checksum     string   `json:"checksum"`
+	Version      string   `json:"version"`
+	Capabilities []string `json:"capabilities"`
+	Fields       any      `json:"fields"`
+}
@@ -12,8 +12,10 @@ type DeploymentsClient interface {
 	Create(ctx context.Context, data DeploymentCreate) (*Deployment, error)
 	Get(ctx context.Context, deploymentID uuid.UUID) (*Deployment, error)
 	GetByName(ctx context.Context, flowName, deploymentName string) (*Deployment, error)
+	List(ctx context.Context, filter DeploymentFilter) ([]*Deployment, error)
 	Update(ctx context.Context, deploymentID uuid.UUID, data DeploymentUpdate) error
 	Delete(ctx context.Context, deploymentID uuid.UUID) error
+	CreateFlowRun(ctx context.Context, deploymentID uuid.UUID, data DeploymentFlowRunCreate) (*FlowRun, error)
 }
 
 // Deployment is a representation of a deployment.
@@ -36,6 +38,7 @@ type Deployment struct {
 	Path                   string                         `json:"path"`
 	Paused                 bool                           `json:"paused"`
 	PullSteps              []PullStep                     `json:"pull_steps"`
+	Schedules              []DeploymentSchedule           `json:"schedules"`
 	StorageDocumentID      uuid.UUID                      `json:"storage_document_id"`
 	Tags                   []string                       `json:"tags"`
 	Version                string                         `json:"version"`
@@ -45,25 +48,26 @@ type Deployment struct {
 
 // DeploymentCreate is a subset of Deployment used when creating deployments.
 type DeploymentCreate struct {
-	ConcurrencyLimit         *int64              `json:"concurrency_limit,omitempty"`
-	ConcurrencyOptions       *ConcurrencyOptions `json:"concurrency_options,omitempty"`
-	Description              string              `json:"description,omitempty"`
-	EnforceParameterSchema   *bool               `json:"enforce_parameter_schema,omitempty"`
-	Entrypoint               string              `json:"entrypoint,omitempty"`
-	FlowID                   uuid.UUID           `json:"flow_id"` // required
-	GlobalConcurrencyLimitID *uuid.UUID          `json:"global_concurrency_limit_id,omitempty"`
-	JobVariables             map[string]any      `json:"job_variables,omitempty"`
-	Name                     string              `json:"name"` // required
-	ParameterOpenAPISchema   map[string]any      `json:"parameter_openapi_schema,omitempty"`
-	Parameters               map[string]any      `json:"parameters,omitempty"`
-	Path                     string              `json:"path,omitempty"`
-	Paused                   bool                `json:"paused,omitempty"`
-	PullSteps                []PullStep          `json:"pull_steps,omitempty"`
-	StorageDocumentID        *uuid.UUID          `json:"storage_document_id,omitempty"`
-	Tags                     []string            `json:"tags,omitempty"`
-	Version                  string              `json:"version,omitempty"`
-	WorkPoolName             string              `json:"work_pool_name,omitempty"`
-	WorkQueueName            string              `json:"work_queue_name,omitempty"`
+	ConcurrencyLimit         *int64                      `json:"concurrency_limit,omitempty"`
+	ConcurrencyOptions       *ConcurrencyOptions         `json:"concurrency_options,omitempty"`
+	Description              string                      `json:"description,omitempty"`
+	EnforceParameterSchema   *bool                       `json:"enforce_parameter_schema,omitempty"`
+	Entrypoint               string                      `json:"entrypoint,omitempty"`
+	FlowID                   uuid.UUID                   `json:"flow_id"` // required
+	GlobalConcurrencyLimitID *uuid.UUID                  `json:"global_concurrency_limit_id,omitempty"`
+	JobVariables             map[string]any              `json:"job_variables,omitempty"`
+	Name                     string                      `json:"name"` // required
+	ParameterOpenAPISchema   map[string]any              `json:"parameter_openapi_schema,omitempty"`
+	Parameters               map[string]any              `json:"parameters,omitempty"`
+	Path                     string                      `json:"path,omitempty"`
+	Paused                   bool                        `json:"paused,omitempty"`
+	PullSteps                []PullStep                  `json:"pull_steps,omitempty"`
+	Schedules                []DeploymentSchedulePayload `json:"schedules,omitempty"`
+	StorageDocumentID        *uuid.UUID                  `json:"storage_document_id,omitempty"`
+	Tags                     []string                    `json:"tags,omitempty"`
+	Version                  string                      `json:"version,omitempty"`
+	WorkPoolName             string                      `json:"work_pool_name,omitempty"`
+	WorkQueueName            string                      `json:"work_queue_name,omitempty"`
 }
 
 // DeploymentUpdate is a subset of Deployment used when updating deployments.
@@ -99,6 +103,68 @@ type DeploymentUpdate struct {
 	WorkQueueName            *string         `json:"work_queue_name,omitempty"`
 }
 
+// DeploymentFilter defines the search filter payload
+// for the POST /deployments/filter endpoint.
+// example request payload:
+// {"flows": {"name": {"any_": ["my-flow"]}}, "deployments": {"tags": {"all_": ["prod"]}}}.
+type DeploymentFilter struct {
+	Deployments *DeploymentFilterDeployments `json:"deployments,omitempty"`
+	Flows       *DeploymentFilterFlows       `json:"flows,omitempty"`
+	WorkPools   *DeploymentFilterWorkPools   `json:"work_pools,omitempty"`
+}
+
+// DeploymentFilterDeployments filters on the attributes of the deployments.
+type DeploymentFilterDeployments struct {
+	Name          *DeploymentFilterName   `json:"name,omitempty"`
+	Paused        *DeploymentFilterPaused `json:"paused,omitempty"`
+	Tags          *DeploymentFilterTags   `json:"tags,omitempty"`
+	WorkQueueName *DeploymentFilterName   `json:"work_queue_name,omitempty"`
+}
+
+// DeploymentFilterFlows filters on the flows the deployments belong to.
+type DeploymentFilterFlows struct {
+	ID   *DeploymentFilterID   `json:"id,omitempty"`
+	Name *DeploymentFilterName `json:"name,omitempty"`
+}
+
+// DeploymentFilterWorkPools filters on the work pools the deployments run on.
+type DeploymentFilterWorkPools struct {
+	Name *DeploymentFilterName `json:"name,omitempty"`
+}
+
+// DeploymentFilterID matches by ID.
+type DeploymentFilterID struct {
+	Any []uuid.UUID `json:"any_,omitempty"`
+}
+
+// DeploymentFilterName matches a name exactly (any_), or by a
+// case-insensitive partial match (like_).
+type DeploymentFilterName struct {
+	Any  []string `json:"any_,omitempty"`
+	Like *string  `json:"like_,omitempty"`
+}
+
+// DeploymentFilterPaused matches deployments by their paused state.
+type DeploymentFilterPaused struct {
+	Eq *bool `json:"eq_,omitempty"`
+}
+
+// DeploymentFilterTags matches deployments that have all (all_)
+// or any (any_) of the given tags.
+type DeploymentFilterTags struct {
+	All []string `json:"all_,omitempty"`
+	Any []string `json:"any_,omitempty"`
+}
+
+// DeploymentFilterRequest wraps DeploymentFilter with pagination parameters
+// for the POST /deployments/filter endpoint.
+type DeploymentFilterRequest struct {
+	DeploymentFilter
+	Sort   string `json:"sort,omitempty"`
+	Limit  *int64 `json:"limit,omitempty"`
+	Offset *int64 `json:"offset,omitempty"`
+}
+
 // ConcurrencyOptions is a representation of the deployment concurrency options.
 type ConcurrencyOptions struct {
 	CollisionStrategy string `json:"collision_strategy"`
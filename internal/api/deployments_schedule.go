@@ -42,7 +42,7 @@ type Schedule struct {
 
 	// Schedule kind: cron
 	Cron  string `json:"cron,omitempty"`
-	DayOr bool   `json:"day_or,omitempty"`
+	DayOr *bool  `json:"day_or,omitempty"`
 
 	// Schedule kind: rrule
 	RRule string `json:"rrule,omitempty"`
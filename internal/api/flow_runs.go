@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FlowRunsClient is a client for working with flow runs.
+type FlowRunsClient interface {
+	Get(ctx context.Context, flowRunID uuid.UUID) (*FlowRun, error)
+}
+
+// StateType is the type of a flow run state.
+type StateType string
+
+// Flow run state types reported by the Prefect API.
+const (
+	StateTypeScheduled  StateType = "SCHEDULED"
+	StateTypePending    StateType = "PENDING"
+	StateTypeRunning    StateType = "RUNNING"
+	StateTypePaused     StateType = "PAUSED"
+	StateTypeCancelling StateType = "CANCELLING"
+	StateTypeCompleted  StateType = "COMPLETED"
+	StateTypeCancelled  StateType = "CANCELLED"
+	StateTypeFailed     StateType = "FAILED"
+	StateTypeCrashed    StateType = "CRASHED"
+)
+
+// IsTerminal reports whether a flow run in this state has finished running.
+func (s StateType) IsTerminal() bool {
+	switch s {
+	case StateTypeCompleted, StateTypeCancelled, StateTypeFailed, StateTypeCrashed:
+		return true
+	default:
+		return false
+	}
+}
+
+// FlowRun is a representation of a flow run.
+type FlowRun struct {
+	BaseModel
+	Name           string         `json:"name"`
+	FlowID         uuid.UUID      `json:"flow_id"`
+	DeploymentID   *uuid.UUID     `json:"deployment_id"`
+	Parameters     map[string]any `json:"parameters"`
+	JobVariables   map[string]any `json:"job_variables"`
+	IdempotencyKey *string        `json:"idempotency_key"`
+	Tags           []string       `json:"tags"`
+	StateType      StateType      `json:"state_type"`
+	StateName      string         `json:"state_name"`
+	State          *FlowRunState  `json:"state"`
+}
+
+// FlowRunState is the current state of a flow run.
+type FlowRunState struct {
+	Type    StateType `json:"type"`
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+}
+
+// DeploymentFlowRunCreate is the payload used when creating
+// a flow run from a deployment.
+type DeploymentFlowRunCreate struct {
+	Name           string         `json:"name,omitempty"`
+	Parameters     map[string]any `json:"parameters,omitempty"`
+	JobVariables   map[string]any `json:"job_variables,omitempty"`
+	Tags           []string       `json:"tags,omitempty"`
+	IdempotencyKey string         `json:"idempotency_key,omitempty"`
+}
@@ -10,7 +10,7 @@ import (
 type FlowsClient interface {
 	Create(ctx context.Context, data FlowCreate) (*Flow, error)
 	Get(ctx context.Context, flowID uuid.UUID) (*Flow, error)
-	List(ctx context.Context, names []string) ([]*Flow, error)
+	List(ctx context.Context, filter FlowFilter) ([]*Flow, error)
 	Update(ctx context.Context, flowID uuid.UUID, data FlowUpdate) error
 	Delete(ctx context.Context, flowID uuid.UUID) error
 }
@@ -36,13 +36,42 @@ type FlowUpdate struct {
 }
 
 // FlowFilter defines the search filter payload
-// when searching for flows by name.
+// for the POST /flows/filter endpoint.
 // example request payload:
-// {"flows": {"name": {"any_": ["test"]}}}.
+// {"flows": {"name": {"any_": ["test"]}, "tags": {"all_": ["prod"]}}}.
 type FlowFilter struct {
-	Flows struct {
-		Name struct {
-			Any []string `json:"any_"`
-		} `json:"name"`
-	} `json:"flows"`
+	Flows *FlowFilterFlows `json:"flows,omitempty"`
+}
+
+// FlowFilterFlows filters on the attributes of the flows.
+type FlowFilterFlows struct {
+	ID   *FlowFilterID   `json:"id,omitempty"`
+	Name *FlowFilterName `json:"name,omitempty"`
+	Tags *FlowFilterTags `json:"tags,omitempty"`
+}
+
+// FlowFilterID matches flows by ID.
+type FlowFilterID struct {
+	Any []uuid.UUID `json:"any_,omitempty"`
+}
+
+// FlowFilterName matches a name exactly (any_), or by a
+// case-insensitive partial match (like_).
+type FlowFilterName struct {
+	Any  []string `json:"any_,omitempty"`
+	Like *string  `json:"like_,omitempty"`
+}
+
+// FlowFilterTags matches flows that have all (all_) of the given tags.
+type FlowFilterTags struct {
+	All []string `json:"all_,omitempty"`
+}
+
+// FlowFilterRequest wraps FlowFilter with pagination parameters
+// for the POST /flows/filter endpoint.
+type FlowFilterRequest struct {
+	FlowFilter
+	Sort   string `json:"sort,omitempty"`
+	Limit  *int64 `json:"limit,omitempty"`
+	Offset *int64 `json:"offset,omitempty"`
 }
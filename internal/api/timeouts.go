@@ -0,0 +1,13 @@
+package api
+
+import "time"
+
+// Timeouts holds the provider-wide default timeouts for resource operations.
+// A zero duration means that no deadline is applied to the operation,
+// unless one is configured in the resource's `timeouts` block.
+type Timeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
@@ -32,47 +33,51 @@ type VariableCreate struct {
 }
 
 // VariableUpdate is a subset of Variable used when updating variables.
+//
+// Value is the JSON-encoded value, so that a JSON null can be sent to clear
+// the value, while a nil Value is left out of the request to keep the existing
+// value. The standard `omitempty` tag cannot tell the two apart on an `any`.
 type VariableUpdate struct {
-	Name  string   `json:"name"`
-	Value any      `json:"value"`
-	Tags  []string `json:"tags"`
+	Name  string          `json:"name"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Tags  []string        `json:"tags"`
 }
 
 // VariableFilterSettings defines settings when searching for variables.
 type VariableFilterSettings struct {
-	Limit     *int64          `json:"limit"`
-	Offset    *int64          `json:"offset"`
-	Variables *VariableFilter `json:"variables"`
-	Sort      string          `json:"sort"`
+	Limit     *int64          `json:"limit,omitempty"`
+	Offset    *int64          `json:"offset,omitempty"`
+	Variables *VariableFilter `json:"variables,omitempty"`
+	Sort      string          `json:"sort,omitempty"`
 }
 
 // VariableFilter defines filters when searching for variables.
 type VariableFilter struct {
-	ID    *VariableFilterID    `json:"id"`
-	Name  *VariableFilterName  `json:"name"`
-	Value *VariableFilterValue `json:"value"`
-	Tags  *VariableFilterTags  `json:"tags"`
+	ID    *VariableFilterID    `json:"id,omitempty"`
+	Name  *VariableFilterName  `json:"name,omitempty"`
+	Value *VariableFilterValue `json:"value,omitempty"`
+	Tags  *VariableFilterTags  `json:"tags,omitempty"`
 }
 
 // VariableFilterID defines filter criteria searching on variable IDs.
 type VariableFilterID struct {
-	Any string `json:"any_"`
+	Any []uuid.UUID `json:"any_,omitempty"`
 }
 
 // VariableFilterName defines filter criteria searching on variable names.
 type VariableFilterName struct {
-	Any  string `json:"any_"`
-	Like string `json:"like_"`
+	Any  []string `json:"any_,omitempty"`
+	Like *string  `json:"like_,omitempty"`
 }
 
 // VariableFilterValue defines filter criteria searching on variable values.
 type VariableFilterValue struct {
-	Any  string `json:"any_"`
-	Like string `json:"like_"`
+	Any  []string `json:"any_,omitempty"`
+	Like *string  `json:"like_,omitempty"`
 }
 
-// VariableFilterTags defines filter criteria searching on variable IDs.
+// VariableFilterTags defines filter criteria searching on variable tags.
 type VariableFilterTags struct {
-	All  string `json:"all_"`
-	Null bool   `json:"is_null_"`
+	All    []string `json:"all_,omitempty"`
+	IsNull *bool    `json:"is_null_,omitempty"`
 }
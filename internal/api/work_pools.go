@@ -25,6 +25,17 @@ type WorkPool struct {
 	IsPaused         bool           `json:"is_paused"`
 	ConcurrencyLimit *int64         `json:"concurrency_limit"`
 	DefaultQueueID   uuid.UUID      `json:"default_queue_id"`
+	Status           *string        `json:"status"`
+
+	StorageConfiguration *WorkPoolStorageConfiguration `json:"storage_configuration"`
+}
+
+// WorkPoolStorageConfiguration is the storage configuration of a work pool,
+// used to upload and execute flow code bundles, and to persist results.
+type WorkPoolStorageConfiguration struct {
+	BundleUploadStep            map[string]any `json:"bundle_upload_step"`
+	BundleExecutionStep         map[string]any `json:"bundle_execution_step"`
+	DefaultResultStorageBlockID *uuid.UUID     `json:"default_result_storage_block_id"`
 }
 
 // WorkPoolCreate is a subset of WorkPool used when creating pools.
@@ -35,6 +46,8 @@ type WorkPoolCreate struct {
 	BaseJobTemplate  *map[string]any `json:"base_job_template,omitempty"`
 	IsPaused         bool            `json:"is_paused"`
 	ConcurrencyLimit *int64          `json:"concurrency_limit"`
+
+	StorageConfiguration *WorkPoolStorageConfiguration `json:"storage_configuration,omitempty"`
 }
 
 // WorkPoolUpdate is a subset of WorkPool used when updating pools.
@@ -43,6 +56,8 @@ type WorkPoolUpdate struct {
 	IsPaused         *bool           `json:"is_paused"`
 	BaseJobTemplate  *map[string]any `json:"base_job_template,omitempty"`
 	ConcurrencyLimit *int64          `json:"concurrency_limit"`
+
+	StorageConfiguration *WorkPoolStorageConfiguration `json:"storage_configuration,omitempty"`
 }
 
 // WorkPoolFilter defines filters when searching for work pools.
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -18,13 +19,15 @@ type WorkQueuesClient interface {
 // WorkQueue is a representation of a work queue.
 type WorkQueue struct {
 	BaseModel
-	Name             string    `json:"name"`
-	WorkPoolName     string    `json:"work_pool_name"`
-	Description      *string   `json:"description"`
-	IsPaused         bool      `json:"is_paused"`
-	ConcurrencyLimit *int64    `json:"concurrency_limit"`
-	Priority         *int64    `json:"priority"`
-	QueueID          uuid.UUID `json:"queue_id"`
+	Name             string     `json:"name"`
+	WorkPoolName     string     `json:"work_pool_name"`
+	Description      *string    `json:"description"`
+	IsPaused         bool       `json:"is_paused"`
+	ConcurrencyLimit *int64     `json:"concurrency_limit"`
+	Priority         *int64     `json:"priority"`
+	QueueID          uuid.UUID  `json:"queue_id"`
+	Status           *string    `json:"status"`
+	LastPolled       *time.Time `json:"last_polled"`
 }
 
 // WorkQueueCreate is a subset of WorkQueue used when creating queues.
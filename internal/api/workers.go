@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkersClient is a client for working with the workers of a work pool.
+type WorkersClient interface {
+	List(ctx context.Context, filter WorkerFilter) ([]*Worker, error)
+}
+
+// Worker is a representation of a worker polling a work pool.
+type Worker struct {
+	BaseModel
+	Name                     string     `json:"name"`
+	WorkPoolID               uuid.UUID  `json:"work_pool_id"`
+	LastHeartbeatTime        *time.Time `json:"last_heartbeat_time"`
+	HeartbeatIntervalSeconds *int64     `json:"heartbeat_interval_seconds"`
+	Status                   string     `json:"status"`
+}
+
+// WorkerFilter defines filters when searching for workers.
+type WorkerFilter struct {
+	// Status matches workers by their status, for example `ONLINE` or `OFFLINE`.
+	Status []string
+}
+
+// WorkerFilterRequest is the request body of the POST /work_pools/{name}/workers/filter endpoint.
+type WorkerFilterRequest struct {
+	Workers *WorkerFilterWorkers `json:"workers,omitempty"`
+	Limit   *int64               `json:"limit,omitempty"`
+	Offset  *int64               `json:"offset,omitempty"`
+}
+
+// WorkerFilterWorkers defines filter criteria searching on workers.
+type WorkerFilterWorkers struct {
+	Status *WorkerFilterStatus `json:"status,omitempty"`
+}
+
+// WorkerFilterStatus defines filter criteria searching on worker statuses.
+type WorkerFilterStatus struct {
+	Any []string `json:"any_,omitempty"`
+}
@@ -0,0 +1,17 @@
+package api
+
+import (
+	"context"
+)
+
+// WorkspaceScopesClient is a client for listing the scopes
+// that can be granted to a Workspace Role.
+type WorkspaceScopesClient interface {
+	List(ctx context.Context) ([]*WorkspaceScope, error)
+}
+
+// WorkspaceScope is a representation of a workspace scope.
+type WorkspaceScope struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.AccountInvitationsClient(&AccountInvitationsClient{})
+
+// AccountInvitationsClient is a client for working with Account Invitations.
+type AccountInvitationsClient struct {
+	hc              *http.Client
+	apiKey          string
+	basicAuthKey    string
+	routePrefix     string
+	csrfClientToken string
+	csrfToken       string
+	customHeaders   map[string]string
+}
+
+// AccountInvitations is a factory that initializes and returns an AccountInvitationsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) AccountInvitations(accountID uuid.UUID) (api.AccountInvitationsClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+
+	return &AccountInvitationsClient{
+		hc:              c.hc,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		routePrefix:     getAccountScopedURL(c.endpoint, accountID, "invitations"),
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+	}, nil
+}
+
+// Create invites a user to the account.
+func (c *AccountInvitationsClient) Create(ctx context.Context, data api.AccountInvitationCreate) (*api.AccountInvitation, error) {
+	cfg := requestConfig{
+		method:          http.MethodPost,
+		url:             c.routePrefix + "/",
+		body:            &data,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOKOrCreated,
+	}
+
+	var invitation api.AccountInvitation
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &invitation); err != nil {
+		return nil, fmt.Errorf("failed to create account invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// Get returns an account invitation by ID.
+func (c *AccountInvitationsClient) Get(ctx context.Context, invitationID uuid.UUID) (*api.AccountInvitation, error) {
+	cfg := requestConfig{
+		method:          http.MethodGet,
+		url:             fmt.Sprintf("%s/%s", c.routePrefix, invitationID.String()),
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOK,
+	}
+
+	var invitation api.AccountInvitation
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &invitation); err != nil {
+		return nil, fmt.Errorf("failed to get account invitation: %w", err)
+	}
+
+	return &invitation, nil
+}
+
+// Delete revokes an account invitation by ID.
+func (c *AccountInvitationsClient) Delete(ctx context.Context, invitationID uuid.UUID) error {
+	cfg := requestConfig{
+		method:          http.MethodDelete,
+		url:             fmt.Sprintf("%s/%s", c.routePrefix, invitationID.String()),
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusNoContent,
+	}
+
+	resp, err := request(ctx, c.hc, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to delete account invitation: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -87,3 +87,71 @@ func (c *AccountRolesClient) Get(ctx context.Context, roleID uuid.UUID) (*api.Ac
 
 	return &accountRole, nil
 }
+
+// Create creates a new account role.
+func (c *AccountRolesClient) Create(ctx context.Context, data api.AccountRoleUpsert) (*api.AccountRole, error) {
+	cfg := requestConfig{
+		method:          http.MethodPost,
+		url:             c.routePrefix + "/",
+		body:            &data,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusCreated,
+	}
+
+	var accountRole api.AccountRole
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &accountRole); err != nil {
+		return nil, fmt.Errorf("failed to create account role: %w", err)
+	}
+
+	return &accountRole, nil
+}
+
+// Update modifies an existing account role by ID.
+func (c *AccountRolesClient) Update(ctx context.Context, roleID uuid.UUID, data api.AccountRoleUpsert) error {
+	cfg := requestConfig{
+		method:          http.MethodPatch,
+		url:             fmt.Sprintf("%s/%s", c.routePrefix, roleID.String()),
+		body:            &data,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusNoContent,
+	}
+
+	resp, err := request(ctx, c.hc, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to update account role: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// Delete removes an account role by ID.
+func (c *AccountRolesClient) Delete(ctx context.Context, roleID uuid.UUID) error {
+	cfg := requestConfig{
+		method:          http.MethodDelete,
+		url:             fmt.Sprintf("%s/%s", c.routePrefix, roleID.String()),
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusNoContent,
+	}
+
+	resp, err := request(ctx, c.hc, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to delete account role: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
@@ -69,6 +72,118 @@ func (c *AutomationsClient) Get(ctx context.Context, id uuid.UUID) (*api.Automat
 	return &automation, nil
 }
 
+const automationsDefaultPageSize int64 = 200
+
+// List returns a list of automations matching filter criteria.
+// It paginates through all results automatically using offset/limit.
+func (c *AutomationsClient) List(ctx context.Context, filter api.AutomationFilter) ([]*api.Automation, error) {
+	var automations []*api.Automation
+	var err error
+
+	if filter.RelatedResourceID != "" {
+		automations, err = c.listRelatedTo(ctx, filter.RelatedResourceID)
+	} else {
+		automations, err = c.listFiltered(ctx, filter.Names)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The API does not filter on every attribute, so the
+	// remaining filters are applied to the results here.
+	filtered := make([]*api.Automation, 0, len(automations))
+	for _, automation := range automations {
+		if len(filter.Names) > 0 && !slices.Contains(filter.Names, automation.Name) {
+			continue
+		}
+
+		if filter.Enabled != nil && automation.Enabled != *filter.Enabled {
+			continue
+		}
+
+		filtered = append(filtered, automation)
+	}
+
+	return filtered, nil
+}
+
+// listFiltered returns the automations matching the given names, or all automations.
+func (c *AutomationsClient) listFiltered(ctx context.Context, names []string) ([]*api.Automation, error) {
+	filterQuery := api.AutomationFilterRequest{
+		// Sort by name so that pages are stable while paginating.
+		Sort: "NAME_ASC",
+	}
+
+	if len(names) > 0 {
+		filterQuery.Automations = &api.AutomationFilterAutomations{
+			Name: &api.AutomationFilterName{Any: names},
+		}
+	}
+
+	var allAutomations []*api.Automation
+	offset := int64(0)
+	limit := automationsDefaultPageSize
+
+	for {
+		filterQuery.Offset = &offset
+		filterQuery.Limit = &limit
+
+		cfg := requestConfig{
+			method:          http.MethodPost,
+			url:             c.routePrefix + "/filter",
+			body:            &filterQuery,
+			apiKey:          c.apiKey,
+			basicAuthKey:    c.basicAuthKey,
+			csrfClientToken: c.csrfClientToken,
+			csrfToken:       c.csrfToken,
+			customHeaders:   c.customHeaders,
+			successCodes:    successCodesStatusOK,
+		}
+
+		var page []*api.Automation
+		if err := requestWithDecodeResponse(ctx, c.hc, cfg, &page); err != nil {
+			return nil, fmt.Errorf("failed to list automations: %w", err)
+		}
+
+		allAutomations = append(allAutomations, page...)
+
+		if int64(len(page)) < limit {
+			break
+		}
+
+		offset += limit
+	}
+
+	return allAutomations, nil
+}
+
+// listRelatedTo returns the automations related to a resource, sorted by name.
+func (c *AutomationsClient) listRelatedTo(ctx context.Context, resourceID string) ([]*api.Automation, error) {
+	cfg := requestConfig{
+		method:          http.MethodGet,
+		url:             fmt.Sprintf("%s/related-to/%s", c.routePrefix, url.PathEscape(resourceID)),
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOK,
+	}
+
+	var automations []*api.Automation
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &automations); err != nil {
+		return nil, fmt.Errorf("failed to list automations related to %s: %w", resourceID, err)
+	}
+
+	slices.SortFunc(automations, func(a, b *api.Automation) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return automations, nil
+}
+
 func (c *AutomationsClient) Create(ctx context.Context, payload api.AutomationUpsert) (*api.Automation, error) {
 	cfg := requestConfig{
 		method:          http.MethodPost,
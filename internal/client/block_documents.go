@@ -99,6 +99,55 @@ func (c *BlockDocumentClient) GetByName(ctx context.Context, typeSlug, name stri
 	return &blockDocument, nil
 }
 
+const blockDocumentsDefaultPageSize int64 = 200
+
+// List returns a list of block documents matching filter criteria, without their secrets.
+// It paginates through all results automatically using offset/limit.
+func (c *BlockDocumentClient) List(ctx context.Context, filter api.BlockDocumentFilter) ([]*api.BlockDocument, error) {
+	filterQuery := api.BlockDocumentFilterRequest{
+		BlockDocumentFilter: filter,
+		IncludeSecrets:      false,
+		// Sort by name so that pages are stable while paginating.
+		Sort: "NAME_ASC",
+	}
+
+	var allBlockDocuments []*api.BlockDocument
+	offset := int64(0)
+	limit := blockDocumentsDefaultPageSize
+
+	for {
+		filterQuery.Offset = &offset
+		filterQuery.Limit = &limit
+
+		cfg := requestConfig{
+			method:          http.MethodPost,
+			url:             c.routePrefix + "/filter",
+			body:            &filterQuery,
+			apiKey:          c.apiKey,
+			basicAuthKey:    c.basicAuthKey,
+			csrfClientToken: c.csrfClientToken,
+			csrfToken:       c.csrfToken,
+			customHeaders:   c.customHeaders,
+			successCodes:    successCodesStatusOK,
+		}
+
+		var page []*api.BlockDocument
+		if err := requestWithDecodeResponse(ctx, c.hc, cfg, &page); err != nil {
+			return nil, fmt.Errorf("failed to list block documents: %w", err)
+		}
+
+		allBlockDocuments = append(allBlockDocuments, page...)
+
+		if int64(len(page)) < limit {
+			break
+		}
+
+		offset += limit
+	}
+
+	return allBlockDocuments, nil
+}
+
 func (c *BlockDocumentClient) Create(ctx context.Context, payload api.BlockDocumentCreate) (*api.BlockDocument, error) {
 	cfg := requestConfig{
 		method:          http.MethodPost,
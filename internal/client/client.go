@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -180,6 +181,15 @@ func WithDefaults(accountID uuid.UUID, workspaceID uuid.UUID) Option {
 	}
 }
 
+// WithDefaultTimeouts configures the provider-wide default timeouts for resource operations.
+func WithDefaultTimeouts(timeouts api.Timeouts) Option {
+	return func(client *Client) error {
+		client.defaultTimeouts = timeouts
+
+		return nil
+	}
+}
+
 // WithCustomHeaders configures custom HTTP headers to include in all API requests.
 // Protected headers (User-Agent, Prefect-Csrf-Token, Prefect-Csrf-Client) are filtered out
 // and a warning is logged if any are attempted to be overridden.
@@ -220,6 +230,12 @@ func WithCustomHeaders(headers map[string]string) Option {
 }
 
 func checkRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// If the context was canceled or its deadline was exceeded (for example,
+	// because a resource's configured timeout elapsed), stop retrying.
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
 	// If the response is empty, there was a problem with the request,
 	// so try again.
 	if resp == nil {
@@ -260,6 +276,12 @@ func checkRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool
 			return false, errResult
 		}
 
+		// Callers that expect the object to be missing (e.g. when waiting
+		// for a deletion to complete) can opt out of 404 retries.
+		if helpers.NotFoundRetriesDisabled(ctx) {
+			return false, errResult
+		}
+
 		return true, errResult
 	}
 
@@ -7,8 +7,11 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -109,6 +112,25 @@ func TestCheckRetryPolicy_NotFound_POST(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCheckRetryPolicy_NotFound_RetriesDisabled(t *testing.T) {
+	t.Parallel()
+
+	body := `{"detail": "not found"}`
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+
+	ctx := context.WithValue(context.Background(), client.HTTPMethodContextKey, http.MethodGet)
+	ctx = helpers.ContextWithoutNotFoundRetries(ctx)
+
+	retry, err := client.CheckRetryPolicy(ctx, resp, nil)
+
+	assert.False(t, retry, "should not retry 404 when the caller expects the object to be missing")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "status_code=404")
+}
+
 func TestCheckRetryPolicy_TooManyRequests(t *testing.T) {
 	t.Parallel()
 
@@ -141,6 +163,35 @@ func TestCheckRetryPolicy_ServiceUnavailable(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCheckRetryPolicy_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+
+	retry, err := client.CheckRetryPolicy(ctx, resp, nil)
+
+	assert.False(t, retry, "should not retry once the context is canceled")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCheckRetryPolicy_ContextDeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	retry, err := client.CheckRetryPolicy(ctx, nil, nil)
+
+	assert.False(t, retry, "should not retry once the context deadline is exceeded")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestCheckRetryPolicy_Success(t *testing.T) {
 	t.Parallel()
 
@@ -181,6 +232,21 @@ func TestClientCreation_WithOptions(t *testing.T) {
 	assert.Equal(t, "test-api-key", c.APIKey())
 }
 
+// TestClientCreation_WithDefaultTimeouts verifies the provider-wide default timeouts are exposed.
+func TestClientCreation_WithDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	timeouts := api.Timeouts{
+		Create: 10 * time.Minute,
+		Delete: 5 * time.Minute,
+	}
+
+	c, err := client.New(client.WithDefaultTimeouts(timeouts))
+
+	require.NoError(t, err)
+	assert.Equal(t, timeouts, c.GetDefaultTimeouts())
+}
+
 // TestClientCreation_InvalidEndpoint verifies error handling for invalid endpoints.
 func TestClientCreation_InvalidEndpoint(t *testing.T) {
 	t.Parallel()
@@ -78,3 +78,28 @@ func (c *CollectionsClient) GetWorkerMetadataViews(ctx context.Context) (api.Wor
 
 	return workerTypeByPackage, nil
 }
+
+// GetBlockMetadataViews returns a map of block metadata views by prefect package name.
+// This endpoint serves the block types and schemas of the latest release of each collection.
+func (c *CollectionsClient) GetBlockMetadataViews(ctx context.Context) (api.BlockTypeByPackage, error) {
+	url := fmt.Sprintf("%s/%s", c.routePrefix, "views/aggregate-block-metadata")
+
+	cfg := requestConfig{
+		method:          http.MethodGet,
+		url:             url,
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOK,
+	}
+
+	var blockTypeByPackage api.BlockTypeByPackage
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &blockTypeByPackage); err != nil {
+		return nil, fmt.Errorf("failed to get block type by package: %w", err)
+	}
+
+	return blockTypeByPackage, nil
+}
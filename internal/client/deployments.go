@@ -117,6 +117,54 @@ func (c *DeploymentsClient) GetByName(ctx context.Context, flowName, deploymentN
 	return &deployment, nil
 }
 
+const deploymentsDefaultPageSize int64 = 200
+
+// List returns a list of Deployments matching the filter criteria.
+// It paginates through all results automatically using offset/limit.
+func (c *DeploymentsClient) List(ctx context.Context, filter api.DeploymentFilter) ([]*api.Deployment, error) {
+	filterQuery := api.DeploymentFilterRequest{
+		DeploymentFilter: filter,
+		// Sort by name so that pages are stable while paginating.
+		Sort: "NAME_ASC",
+	}
+
+	var allDeployments []*api.Deployment
+	offset := int64(0)
+	limit := deploymentsDefaultPageSize
+
+	for {
+		filterQuery.Offset = &offset
+		filterQuery.Limit = &limit
+
+		cfg := requestConfig{
+			method:          http.MethodPost,
+			url:             c.routePrefix + "/filter",
+			body:            &filterQuery,
+			apiKey:          c.apiKey,
+			basicAuthKey:    c.basicAuthKey,
+			csrfClientToken: c.csrfClientToken,
+			csrfToken:       c.csrfToken,
+			customHeaders:   c.customHeaders,
+			successCodes:    successCodesStatusOK,
+		}
+
+		var page []*api.Deployment
+		if err := requestWithDecodeResponse(ctx, c.hc, cfg, &page); err != nil {
+			return nil, fmt.Errorf("failed to list deployments: %w", err)
+		}
+
+		allDeployments = append(allDeployments, page...)
+
+		if int64(len(page)) < limit {
+			break
+		}
+
+		offset += limit
+	}
+
+	return allDeployments, nil
+}
+
 // Update modifies an existing Deployment by ID.
 func (c *DeploymentsClient) Update(ctx context.Context, id uuid.UUID, data api.DeploymentUpdate) error {
 	cfg := requestConfig{
@@ -162,3 +210,25 @@ func (c *DeploymentsClient) Delete(ctx context.Context, deploymentID uuid.UUID)
 
 	return nil
 }
+
+// CreateFlowRun creates a new flow run from a Deployment.
+func (c *DeploymentsClient) CreateFlowRun(ctx context.Context, deploymentID uuid.UUID, data api.DeploymentFlowRunCreate) (*api.FlowRun, error) {
+	cfg := requestConfig{
+		method:          http.MethodPost,
+		url:             fmt.Sprintf("%s/%s/create_flow_run", c.routePrefix, deploymentID.String()),
+		body:            &data,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOKOrCreated,
+	}
+
+	var flowRun api.FlowRun
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &flowRun); err != nil {
+		return nil, fmt.Errorf("failed to create flow run: %w", err)
+	}
+
+	return &flowRun, nil
+}
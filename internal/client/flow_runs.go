@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.FlowRunsClient(&FlowRunsClient{})
+
+// FlowRunsClient is a client for working with Flow Runs.
+type FlowRunsClient struct {
+	hc              *http.Client
+	routePrefix     string
+	apiKey          string
+	basicAuthKey    string
+	csrfClientToken string
+	csrfToken       string
+	customHeaders   map[string]string
+}
+
+// FlowRuns returns a FlowRunsClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) FlowRuns(accountID uuid.UUID, workspaceID uuid.UUID) (api.FlowRunsClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+
+	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	return &FlowRunsClient{
+		hc:              c.hc,
+		routePrefix:     getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, "flow_runs"),
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+	}, nil
+}
+
+// Get returns details for a Flow Run by ID.
+func (c *FlowRunsClient) Get(ctx context.Context, flowRunID uuid.UUID) (*api.FlowRun, error) {
+	cfg := requestConfig{
+		method:          http.MethodGet,
+		url:             fmt.Sprintf("%s/%s", c.routePrefix, flowRunID.String()),
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOK,
+	}
+
+	var flowRun api.FlowRun
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &flowRun); err != nil {
+		return nil, fmt.Errorf("failed to get flow run: %w", err)
+	}
+
+	return &flowRun, nil
+}
@@ -72,32 +72,52 @@ func (c *FlowsClient) Create(ctx context.Context, data api.FlowCreate) (*api.Flo
 	return &flow, nil
 }
 
-// List returns a list of Flows, based on the provided list of flow names.
-func (c *FlowsClient) List(ctx context.Context, names []string) ([]*api.Flow, error) {
-	filterQuery := api.FlowFilter{}
-
-	if len(names) != 0 {
-		filterQuery.Flows.Name.Any = names
-	}
-
-	cfg := requestConfig{
-		method:          http.MethodPost,
-		url:             fmt.Sprintf("%s/filter", c.routePrefix),
-		body:            &filterQuery,
-		apiKey:          c.apiKey,
-		basicAuthKey:    c.basicAuthKey,
-		csrfClientToken: c.csrfClientToken,
-		csrfToken:       c.csrfToken,
-		customHeaders:   c.customHeaders,
-		successCodes:    successCodesStatusOK,
+const flowsDefaultPageSize int64 = 200
+
+// List returns a list of Flows, based on the provided filter.
+// It paginates through all results automatically using offset/limit.
+func (c *FlowsClient) List(ctx context.Context, filter api.FlowFilter) ([]*api.Flow, error) {
+	filterQuery := api.FlowFilterRequest{
+		FlowFilter: filter,
+		// Sort by name so that pages are stable while paginating.
+		Sort: "NAME_ASC",
 	}
 
-	var flows []*api.Flow
-	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &flows); err != nil {
-		return nil, fmt.Errorf("failed to list flows: %w", err)
+	var allFlows []*api.Flow
+	offset := int64(0)
+	limit := flowsDefaultPageSize
+
+	for {
+		filterQuery.Offset = &offset
+		filterQuery.Limit = &limit
+
+		cfg := requestConfig{
+			method:          http.MethodPost,
+			url:             c.routePrefix + "/filter",
+			body:            &filterQuery,
+			apiKey:          c.apiKey,
+			basicAuthKey:    c.basicAuthKey,
+			csrfClientToken: c.csrfClientToken,
+			csrfToken:       c.csrfToken,
+			customHeaders:   c.customHeaders,
+			successCodes:    successCodesStatusOK,
+		}
+
+		var page []*api.Flow
+		if err := requestWithDecodeResponse(ctx, c.hc, cfg, &page); err != nil {
+			return nil, fmt.Errorf("failed to list flows: %w", err)
+		}
+
+		allFlows = append(allFlows, page...)
+
+		if int64(len(page)) < limit {
+			break
+		}
+
+		offset += limit
 	}
 
-	return flows, nil
+	return allFlows, nil
 }
 
 // Get returns details for a Flow by ID.
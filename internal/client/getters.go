@@ -1,8 +1,16 @@
 package client
 
+import "github.com/prefecthq/terraform-provider-prefect/internal/api"
+
 // GetEndpointHost returns the endpoint host,
 // which is the API domain without the trailing subpath.
 // eg. https://api.prefect.cloud
 func (c *Client) GetEndpointHost() string {
 	return c.endpointHost
 }
+
+// GetDefaultTimeouts returns the provider-wide default timeouts
+// for resource operations.
+func (c *Client) GetDefaultTimeouts() api.Timeouts {
+	return c.defaultTimeouts
+}
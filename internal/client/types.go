@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 )
 
 type Client struct {
@@ -24,6 +26,9 @@ type Client struct {
 
 	defaultAccountID   uuid.UUID
 	defaultWorkspaceID uuid.UUID
+
+	// defaultTimeouts are the provider-wide default timeouts for resource operations.
+	defaultTimeouts api.Timeouts
 }
 
 type Option func(c *Client) error
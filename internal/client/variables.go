@@ -72,12 +72,52 @@ func (c *VariablesClient) Create(ctx context.Context, data api.VariableCreate) (
 	return &variable, nil
 }
 
+const variablesDefaultPageSize int64 = 200
+
 // List returns a list of variables matching filter criteria.
+// It paginates through all results automatically using offset/limit.
 func (c *VariablesClient) List(ctx context.Context, filter api.VariableFilter) ([]api.Variable, error) {
-	_ = ctx
-	_ = filter
+	filterQuery := api.VariableFilterSettings{
+		Variables: &filter,
+		// Sort by name so that pages are stable while paginating.
+		Sort: "NAME_ASC",
+	}
+
+	var allVariables []api.Variable
+	offset := int64(0)
+	limit := variablesDefaultPageSize
+
+	for {
+		filterQuery.Offset = &offset
+		filterQuery.Limit = &limit
+
+		cfg := requestConfig{
+			method:          http.MethodPost,
+			url:             c.routePrefix + "/filter",
+			body:            &filterQuery,
+			apiKey:          c.apiKey,
+			basicAuthKey:    c.basicAuthKey,
+			csrfClientToken: c.csrfClientToken,
+			csrfToken:       c.csrfToken,
+			customHeaders:   c.customHeaders,
+			successCodes:    successCodesStatusOK,
+		}
+
+		var page []api.Variable
+		if err := requestWithDecodeResponse(ctx, c.hc, cfg, &page); err != nil {
+			return nil, fmt.Errorf("failed to list variables: %w", err)
+		}
+
+		allVariables = append(allVariables, page...)
+
+		if int64(len(page)) < limit {
+			break
+		}
+
+		offset += limit
+	}
 
-	return nil, nil
+	return allVariables, nil
 }
 
 // Get returns details for a variable by ID.
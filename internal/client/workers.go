@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+var _ = api.WorkersClient(&WorkersClient{})
+
+// WorkersClient is a client for working with the workers of a work pool.
+type WorkersClient struct {
+	hc              *http.Client
+	apiKey          string
+	basicAuthKey    string
+	routePrefix     string
+	csrfClientToken string
+	csrfToken       string
+	customHeaders   map[string]string
+}
+
+// Workers returns a WorkersClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) Workers(accountID uuid.UUID, workspaceID uuid.UUID, workPoolName string) (api.WorkersClient, error) {
+	if accountID == uuid.Nil {
+		accountID = c.defaultAccountID
+	}
+
+	if workspaceID == uuid.Nil {
+		workspaceID = c.defaultWorkspaceID
+	}
+
+	if err := validateCloudEndpoint(c.endpoint, accountID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("work_pools/%s/workers", workPoolName)
+
+	return &WorkersClient{
+		hc:              c.hc,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		routePrefix:     getWorkspaceScopedURL(c.endpoint, accountID, workspaceID, route),
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+	}, nil
+}
+
+const workersDefaultPageSize int64 = 200
+
+// List returns a list of workers matching filter criteria.
+// It paginates through all results automatically using offset/limit.
+func (c *WorkersClient) List(ctx context.Context, filter api.WorkerFilter) ([]*api.Worker, error) {
+	filterQuery := api.WorkerFilterRequest{}
+
+	if len(filter.Status) > 0 {
+		filterQuery.Workers = &api.WorkerFilterWorkers{
+			Status: &api.WorkerFilterStatus{Any: filter.Status},
+		}
+	}
+
+	var allWorkers []*api.Worker
+	offset := int64(0)
+	limit := workersDefaultPageSize
+
+	for {
+		filterQuery.Offset = &offset
+		filterQuery.Limit = &limit
+
+		cfg := requestConfig{
+			method:          http.MethodPost,
+			url:             c.routePrefix + "/filter",
+			body:            &filterQuery,
+			successCodes:    successCodesStatusOK,
+			apiKey:          c.apiKey,
+			basicAuthKey:    c.basicAuthKey,
+			csrfClientToken: c.csrfClientToken,
+			csrfToken:       c.csrfToken,
+			customHeaders:   c.customHeaders,
+		}
+
+		var page []*api.Worker
+		if err := requestWithDecodeResponse(ctx, c.hc, cfg, &page); err != nil {
+			return nil, fmt.Errorf("failed to list workers: %w", err)
+		}
+
+		allWorkers = append(allWorkers, page...)
+
+		if int64(len(page)) < limit {
+			break
+		}
+
+		offset += limit
+	}
+
+	return allWorkers, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// type assertion ensures that this client implements the interface.
+var _ = api.WorkspaceScopesClient(&WorkspaceScopesClient{})
+
+// WorkspaceScopesClient is a client for listing workspace scopes.
+type WorkspaceScopesClient struct {
+	hc              *http.Client
+	apiKey          string
+	basicAuthKey    string
+	routePrefix     string
+	csrfClientToken string
+	csrfToken       string
+	customHeaders   map[string]string
+}
+
+// WorkspaceScopes is a factory that initializes and returns a WorkspaceScopesClient.
+//
+//nolint:ireturn // required to support PrefectClient mocking
+func (c *Client) WorkspaceScopes() (api.WorkspaceScopesClient, error) {
+	return &WorkspaceScopesClient{
+		hc:              c.hc,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		routePrefix:     fmt.Sprintf("%s/workspace_scopes", c.endpoint),
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+	}, nil
+}
+
+// List returns all of the scopes that can be granted to a workspace role.
+func (c *WorkspaceScopesClient) List(ctx context.Context) ([]*api.WorkspaceScope, error) {
+	cfg := requestConfig{
+		method:          http.MethodGet,
+		url:             c.routePrefix,
+		body:            http.NoBody,
+		apiKey:          c.apiKey,
+		basicAuthKey:    c.basicAuthKey,
+		csrfClientToken: c.csrfClientToken,
+		csrfToken:       c.csrfToken,
+		customHeaders:   c.customHeaders,
+		successCodes:    successCodesStatusOK,
+	}
+
+	var scopes []*api.WorkspaceScope
+	if err := requestWithDecodeResponse(ctx, c.hc, cfg, &scopes); err != nil {
+		return nil, fmt.Errorf("failed to list workspace scopes: %w", err)
+	}
+
+	return scopes, nil
+}
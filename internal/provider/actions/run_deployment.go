@@ -0,0 +1,359 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+const (
+	// defaultRunDeploymentTimeout is how long to wait for a flow run to finish
+	// when neither the action nor the provider configures a timeout.
+	defaultRunDeploymentTimeout = 1 * time.Hour
+
+	// flowRunPollInterval is the delay between flow run state checks.
+	flowRunPollInterval = 5 * time.Second
+)
+
+var (
+	_ = action.ActionWithConfigure(&RunDeploymentAction{})
+	_ = action.ActionWithValidateConfig(&RunDeploymentAction{})
+)
+
+// RunDeploymentAction contains state for the action.
+type RunDeploymentAction struct {
+	client api.PrefectClient
+}
+
+// RunDeploymentActionModel defines the Terraform action model.
+type RunDeploymentActionModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	DeploymentID      customtypes.UUIDValue `tfsdk:"deployment_id"`
+	Name              types.String          `tfsdk:"name"`
+	Parameters        jsontypes.Normalized  `tfsdk:"parameters"`
+	JobVariables      jsontypes.Normalized  `tfsdk:"job_variables"`
+	Tags              types.Set             `tfsdk:"tags"`
+	IdempotencyKey    types.String          `tfsdk:"idempotency_key"`
+	WaitForCompletion types.Bool            `tfsdk:"wait_for_completion"`
+	Timeout           types.String          `tfsdk:"timeout"`
+}
+
+// NewRunDeploymentAction returns a new RunDeploymentAction.
+//
+//nolint:ireturn // required by Terraform API
+func NewRunDeploymentAction() action.Action {
+	return &RunDeploymentAction{}
+}
+
+// Metadata returns the action type name.
+func (a *RunDeploymentAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_deployment"
+}
+
+// Configure initializes runtime state for the action.
+func (a *RunDeploymentAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("action", req.ProviderData))
+
+		return
+	}
+
+	a.client = client
+}
+
+// Schema defines the schema for the action.
+func (a *RunDeploymentAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Creates a flow run from a Deployment, and optionally waits for it to finish.
+<br>
+Use this action to trigger a run from Terraform itself, for example to smoke-test a new deployment version after it is rolled out.
+When `+"`wait_for_completion`"+` is set, the action polls the flow run until it reaches a terminal state,
+and fails if the run ends in a `+"`FAILED`"+` or `+"`CRASHED`"+` state.
+<br>
+Actions require Terraform 1.14 or later.
+<br>
+For more information, see [run deployments](https://docs.prefect.io/v3/deploy/index#running-deployments).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the deployment to run",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the flow run. If not set, Prefect generates a random name.",
+				Optional:    true,
+			},
+			"parameters": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Parameters for the flow run, merged with the deployment's default parameters.",
+				Optional:    true,
+			},
+			"job_variables": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Overrides for the deployment's infrastructure configuration for this flow run.",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags associated with the flow run",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"idempotency_key": schema.StringAttribute{
+				Description: "Idempotency key for the flow run. If a flow run with the same key already exists for the deployment, it is returned instead of creating a new one.",
+				Optional:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the flow run to reach a terminal state. Defaults to `false`.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: fmt.Sprintf("How long to wait for the flow run to finish when `wait_for_completion` is set, as a duration string such as `30m` or `2h`. Defaults to the provider's `default_timeouts.create`, or `%s` if that is not set.", defaultRunDeploymentTimeout),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the action configuration.
+func (a *RunDeploymentAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config RunDeploymentActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Timeout.IsNull() || config.Timeout.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(config.Timeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("Could not parse timeout %q as a duration: %s", config.Timeout.ValueString(), err),
+		)
+	}
+}
+
+// Invoke creates a flow run for the deployment and optionally waits for it to finish.
+func (a *RunDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config RunDeploymentActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := helpers.UnmarshalOptional(config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobVariables, diags := helpers.UnmarshalOptional(config.JobVariables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []string
+	resp.Diagnostics.Append(config.Tags.ElementsAs(ctx, &tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentsClient, err := a.client.Deployments(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	flowRun, err := deploymentsClient.CreateFlowRun(ctx, config.DeploymentID.ValueUUID(), api.DeploymentFlowRunCreate{
+		Name:           config.Name.ValueString(),
+		Parameters:     parameters,
+		JobVariables:   jobVariables,
+		Tags:           tags,
+		IdempotencyKey: config.IdempotencyKey.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow Run", "create", err))
+
+		return
+	}
+
+	tflog.Info(ctx, "Created flow run", map[string]any{"flow_run_id": flowRun.ID.String(), "flow_run_name": flowRun.Name})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Created flow run %q (%s)", flowRun.Name, flowRun.ID),
+	})
+
+	if !config.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := a.client.GetDefaultTimeouts().Create
+	if timeout <= 0 {
+		timeout = defaultRunDeploymentTimeout
+	}
+
+	if !config.Timeout.IsNull() {
+		timeout, err = time.ParseDuration(config.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid Timeout",
+				fmt.Sprintf("Could not parse timeout %q as a duration: %s", config.Timeout.ValueString(), err),
+			)
+
+			return
+		}
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, timeout)
+	defer cancel()
+
+	flowRunsClient, err := a.client.FlowRuns(config.AccountID.ValueUUID(), config.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow Run", err))
+
+		return
+	}
+
+	flowRun, err = waitForFlowRun(ctx, flowRunsClient, flowRun, flowRunPollInterval, func(flowRun *api.FlowRun) {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Flow run %q is %s", flowRun.Name, flowRunStateName(flowRun)),
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for flow run",
+			fmt.Sprintf("Could not wait for flow run %q (%s) to finish: %s", flowRun.Name, flowRun.ID, err),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(flowRunFinalStateDiagnostics(flowRun)...)
+}
+
+// waitForFlowRun polls the flow run every interval until it reaches a terminal
+// state or the context is done, calling onStateChange whenever the reported
+// state changes.
+// It always returns the most recently observed flow run.
+func waitForFlowRun(
+	ctx context.Context,
+	client api.FlowRunsClient,
+	flowRun *api.FlowRun,
+	interval time.Duration,
+	onStateChange func(*api.FlowRun),
+) (*api.FlowRun, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastState := flowRunStateName(flowRun)
+	onStateChange(flowRun)
+
+	for !flowRunStateType(flowRun).IsTerminal() {
+		select {
+		case <-ctx.Done():
+			return flowRun, fmt.Errorf("flow run is still %s: %w", lastState, ctx.Err())
+		case <-ticker.C:
+		}
+
+		latest, err := client.Get(ctx, flowRun.ID)
+		if err != nil {
+			return flowRun, fmt.Errorf("failed to get flow run: %w", err)
+		}
+		flowRun = latest
+
+		if state := flowRunStateName(flowRun); state != lastState {
+			lastState = state
+			onStateChange(flowRun)
+		}
+	}
+
+	return flowRun, nil
+}
+
+// flowRunStateType returns the state type of the flow run.
+func flowRunStateType(flowRun *api.FlowRun) api.StateType {
+	if flowRun.State != nil {
+		return flowRun.State.Type
+	}
+
+	return flowRun.StateType
+}
+
+// flowRunStateName returns a human-readable name for the flow run's state.
+func flowRunStateName(flowRun *api.FlowRun) string {
+	if flowRun.State != nil && flowRun.State.Name != "" {
+		return flowRun.State.Name
+	}
+
+	if flowRun.StateName != "" {
+		return flowRun.StateName
+	}
+
+	return string(flowRunStateType(flowRun))
+}
+
+// flowRunFinalStateDiagnostics reports an error if the flow run finished
+// in a FAILED or CRASHED state, and a warning if it was cancelled.
+func flowRunFinalStateDiagnostics(flowRun *api.FlowRun) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	message := ""
+	if flowRun.State != nil && flowRun.State.Message != "" {
+		message = ": " + flowRun.State.Message
+	}
+
+	switch flowRunStateType(flowRun) {
+	case api.StateTypeFailed, api.StateTypeCrashed:
+		diags.AddError(
+			"Flow run did not succeed",
+			fmt.Sprintf("Flow run %q (%s) finished in state %s%s", flowRun.Name, flowRun.ID, flowRunStateName(flowRun), message),
+		)
+	case api.StateTypeCancelled:
+		diags.AddWarning(
+			"Flow run was cancelled",
+			fmt.Sprintf("Flow run %q (%s) finished in state %s%s", flowRun.Name, flowRun.ID, flowRunStateName(flowRun), message),
+		)
+	default:
+	}
+
+	return diags
+}
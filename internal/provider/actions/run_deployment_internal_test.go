@@ -0,0 +1,157 @@
+package actions // nolint:testpackage // need access to private flow run polling helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// fakeFlowRunsClient returns the configured states in order,
+// repeating the last one once they are exhausted.
+type fakeFlowRunsClient struct {
+	states []api.StateType
+	calls  int
+	err    error
+}
+
+func (c *fakeFlowRunsClient) Get(_ context.Context, flowRunID uuid.UUID) (*api.FlowRun, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	state := c.states[min(c.calls, len(c.states)-1)]
+	c.calls++
+
+	return newFlowRun(flowRunID, state), nil
+}
+
+func newFlowRun(id uuid.UUID, state api.StateType) *api.FlowRun {
+	return &api.FlowRun{
+		BaseModel: api.BaseModel{ID: id},
+		Name:      "smoke-test",
+		StateType: state,
+		State:     &api.FlowRunState{Type: state, Name: string(state)},
+	}
+}
+
+func TestWaitForFlowRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		initial       api.StateType
+		states        []api.StateType
+		clientErr     error
+		timeout       time.Duration
+		expectedState api.StateType
+		expectedSeen  []string
+		expectError   bool
+	}{
+		{
+			name:          "already terminal",
+			initial:       api.StateTypeCompleted,
+			states:        []api.StateType{api.StateTypeCompleted},
+			expectedState: api.StateTypeCompleted,
+			expectedSeen:  []string{"COMPLETED"},
+		},
+		{
+			name:          "polls until terminal",
+			initial:       api.StateTypeScheduled,
+			states:        []api.StateType{api.StateTypeScheduled, api.StateTypePending, api.StateTypeRunning, api.StateTypeRunning, api.StateTypeFailed},
+			expectedState: api.StateTypeFailed,
+			expectedSeen:  []string{"SCHEDULED", "PENDING", "RUNNING", "FAILED"},
+		},
+		{
+			name:          "client error",
+			initial:       api.StateTypeScheduled,
+			clientErr:     errors.New("boom"),
+			expectedState: api.StateTypeScheduled,
+			expectedSeen:  []string{"SCHEDULED"},
+			expectError:   true,
+		},
+		{
+			name:          "timeout",
+			initial:       api.StateTypeRunning,
+			states:        []api.StateType{api.StateTypeRunning},
+			timeout:       50 * time.Millisecond,
+			expectedState: api.StateTypeRunning,
+			expectedSeen:  []string{"RUNNING"},
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			client := &fakeFlowRunsClient{states: tt.states, err: tt.clientErr}
+			seen := []string{}
+
+			flowRun, err := waitForFlowRun(ctx, client, newFlowRun(uuid.New(), tt.initial), time.Millisecond, func(flowRun *api.FlowRun) {
+				seen = append(seen, flowRunStateName(flowRun))
+			})
+
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedState, flowRunStateType(flowRun))
+			assert.Equal(t, tt.expectedSeen, seen)
+		})
+	}
+}
+
+func TestFlowRunFinalStateDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		state         api.StateType
+		expectError   bool
+		expectWarning bool
+	}{
+		{state: api.StateTypeCompleted},
+		{state: api.StateTypeFailed, expectError: true},
+		{state: api.StateTypeCrashed, expectError: true},
+		{state: api.StateTypeCancelled, expectWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			t.Parallel()
+
+			diags := flowRunFinalStateDiagnostics(newFlowRun(uuid.New(), tt.state))
+
+			assert.Equal(t, tt.expectError, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.expectWarning, diags.WarningsCount() > 0, "unexpected diagnostics: %v", diags)
+		})
+	}
+}
+
+func TestFlowRunStateName(t *testing.T) {
+	t.Parallel()
+
+	flowRun := &api.FlowRun{StateType: api.StateTypeScheduled, StateName: "Late"}
+	assert.Equal(t, "Late", flowRunStateName(flowRun))
+
+	flowRun.State = &api.FlowRunState{Type: api.StateTypeRunning, Name: "Running"}
+	assert.Equal(t, "Running", flowRunStateName(flowRun))
+	assert.Equal(t, api.StateTypeRunning, flowRunStateType(flowRun))
+
+	assert.Equal(t, "PENDING", flowRunStateName(&api.FlowRun{StateType: api.StateTypePending}))
+}
@@ -0,0 +1,66 @@
+package actions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccRunDeployment(workspace testutils.Workspace, flowName, deploymentName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_flow" "flow" {
+	name = "%s"
+	%s
+}
+
+resource "prefect_deployment" "deployment" {
+	name = "%s"
+	flow_id = prefect_flow.flow.id
+	%s
+
+	lifecycle {
+		action_trigger {
+			events = [after_create]
+			actions = [action.prefect_run_deployment.run]
+		}
+	}
+}
+
+action "prefect_run_deployment" "run" {
+	config {
+		deployment_id = prefect_deployment.deployment.id
+		parameters = jsonencode({"foo": "bar"})
+		tags = ["terraformacc"]
+		idempotency_key = "%s"
+		%s
+	}
+}
+`, workspace.Resource, flowName, workspace.IDArg, deploymentName, workspace.IDArg, deploymentName, workspace.IDArg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccAction_run_deployment(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	flowName := testutils.NewRandomPrefixedString()
+	deploymentName := testutils.NewRandomPrefixedString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Check that the action creates a flow run when the deployment is created.
+				// There is no worker to pick the run up, so the test does not wait for completion.
+				Config: fixtureAccRunDeployment(workspace, flowName, deploymentName),
+			},
+		},
+	})
+}
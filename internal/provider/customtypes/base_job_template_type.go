@@ -0,0 +1,78 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ = basetypes.StringTypable(&BaseJobTemplateType{})
+	_ = fmt.Stringer(&BaseJobTemplateType{})
+)
+
+// BaseJobTemplateType implements a custom Terraform type that represents
+// a work pool's base job template, as a JSON string.
+type BaseJobTemplateType struct {
+	jsontypes.NormalizedType
+}
+
+// Equal returns true if this type and o are equal.
+func (t BaseJobTemplateType) Equal(o attr.Type) bool {
+	other, ok := o.(BaseJobTemplateType)
+	if !ok {
+		return false
+	}
+
+	return t.NormalizedType.Equal(other.NormalizedType)
+}
+
+// String represents a string representation of BaseJobTemplateType.
+func (t BaseJobTemplateType) String() string {
+	return "BaseJobTemplateType"
+}
+
+// ValueFromString converts a string value to a BaseJobTemplateValue.
+//
+//nolint:ireturn // required to implement StringTypable
+func (t BaseJobTemplateType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value := BaseJobTemplateValue{
+		Normalized: jsontypes.Normalized{StringValue: in},
+	}
+
+	return value, nil
+}
+
+// ValueFromTerraform converts a Terraform value to a BaseJobTemplateValue.
+//
+//nolint:ireturn // required to implement StringTypable
+func (t BaseJobTemplateType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error converting value from Terraform: %w", err)
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// ValueType returns an instance of the value.
+//
+//nolint:ireturn // required to implement StringTypable
+func (t BaseJobTemplateType) ValueType(_ context.Context) attr.Value {
+	return BaseJobTemplateValue{}
+}
@@ -0,0 +1,88 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ = basetypes.StringValuable(&BaseJobTemplateValue{})
+	_ = basetypes.StringValuableWithSemanticEquals(&BaseJobTemplateValue{})
+	_ = fmt.Stringer(&BaseJobTemplateValue{})
+	_ = xattr.ValidateableAttribute(&BaseJobTemplateValue{})
+)
+
+// BaseJobTemplateValue implements a custom Terraform value that represents
+// a work pool's base job template, as a JSON string.
+type BaseJobTemplateValue struct {
+	jsontypes.Normalized
+}
+
+// NewBaseJobTemplateNull creates a BaseJobTemplateValue with a null value.
+func NewBaseJobTemplateNull() BaseJobTemplateValue {
+	return BaseJobTemplateValue{
+		Normalized: jsontypes.NewNormalizedNull(),
+	}
+}
+
+// NewBaseJobTemplateValue creates a BaseJobTemplateValue with a known value.
+func NewBaseJobTemplateValue(value string) BaseJobTemplateValue {
+	return BaseJobTemplateValue{
+		Normalized: jsontypes.NewNormalizedValue(value),
+	}
+}
+
+// Equal returns true if this value is equal to o.
+func (v BaseJobTemplateValue) Equal(o attr.Value) bool {
+	other, ok := o.(BaseJobTemplateValue)
+	if !ok {
+		return false
+	}
+
+	return v.Normalized.Equal(other.Normalized)
+}
+
+// Type returns an instance of the type.
+//
+//nolint:ireturn // required to implement StringValuable
+func (v BaseJobTemplateValue) Type(_ context.Context) attr.Type {
+	return BaseJobTemplateType{}
+}
+
+func (v BaseJobTemplateValue) String() string {
+	return "BaseJobTemplateValue"
+}
+
+// StringSemanticEquals checks if two BaseJobTemplateValue objects have
+// equivalent values, even if they are not equal. On top of the JSON
+// normalization, null members are treated as missing, whitespace in
+// template placeholders is ignored, and JSON Schema keywords that the
+// server adds to the variables schema with their default value are ignored.
+func (v BaseJobTemplateValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(BaseJobTemplateValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	equal, err := jsonSemanticEqual(v.ValueString(), newValue.ValueString(), ignoreJSONSchemaKeywordDefaults("variables"))
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", semanticEqualityErrorMessage(err))
+
+		return false, diags
+	}
+
+	return equal, diags
+}
@@ -0,0 +1,87 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ = basetypes.StringValuable(&JobVariablesValue{})
+	_ = basetypes.StringValuableWithSemanticEquals(&JobVariablesValue{})
+	_ = fmt.Stringer(&JobVariablesValue{})
+	_ = xattr.ValidateableAttribute(&JobVariablesValue{})
+)
+
+// JobVariablesValue implements a custom Terraform value that represents
+// a deployment's job variables, as a JSON string.
+type JobVariablesValue struct {
+	jsontypes.Normalized
+}
+
+// NewJobVariablesNull creates a JobVariablesValue with a null value.
+func NewJobVariablesNull() JobVariablesValue {
+	return JobVariablesValue{
+		Normalized: jsontypes.NewNormalizedNull(),
+	}
+}
+
+// NewJobVariablesValue creates a JobVariablesValue with a known value.
+func NewJobVariablesValue(value string) JobVariablesValue {
+	return JobVariablesValue{
+		Normalized: jsontypes.NewNormalizedValue(value),
+	}
+}
+
+// Equal returns true if this value is equal to o.
+func (v JobVariablesValue) Equal(o attr.Value) bool {
+	other, ok := o.(JobVariablesValue)
+	if !ok {
+		return false
+	}
+
+	return v.Normalized.Equal(other.Normalized)
+}
+
+// Type returns an instance of the type.
+//
+//nolint:ireturn // required to implement StringValuable
+func (v JobVariablesValue) Type(_ context.Context) attr.Type {
+	return JobVariablesType{}
+}
+
+func (v JobVariablesValue) String() string {
+	return "JobVariablesValue"
+}
+
+// StringSemanticEquals checks if two JobVariablesValue objects have
+// equivalent values, even if they are not equal. On top of the JSON
+// normalization, null members are treated as missing and whitespace
+// in template placeholders is ignored.
+func (v JobVariablesValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JobVariablesValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	equal, err := jsonSemanticEqual(v.ValueString(), newValue.ValueString(), nil)
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", semanticEqualityErrorMessage(err))
+
+		return false, diags
+	}
+
+	return equal, diags
+}
@@ -0,0 +1,160 @@
+package customtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// templatePlaceholderRegex matches Jinja-style `{{ name }}` placeholders,
+// capturing the placeholder name without the surrounding whitespace.
+var templatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+
+// jsonSchemaKeywordDefaults are JSON Schema keywords along with the value they
+// default to. Adding one of these keywords with its default value to a schema
+// does not change the meaning of the schema.
+var jsonSchemaKeywordDefaults = map[string]any{
+	"$defs":                map[string]any{},
+	"additionalProperties": true,
+	"definitions":          map[string]any{},
+	"deprecated":           false,
+	"properties":           map[string]any{},
+	"readOnly":             false,
+	"required":             []any{},
+	"uniqueItems":          false,
+	"writeOnly":            false,
+}
+
+// ignoreAddedFunc reports whether a member that is only present in the new value
+// of a semantic comparison can be ignored. The path is the list of object keys
+// leading to the object that holds the member.
+type ignoreAddedFunc func(path []string, key string, value any) bool
+
+// jsonSemanticEqual returns true if the prior and new JSON strings are semantically equal:
+//   - object members with a null value are treated as missing
+//   - whitespace inside `{{ }}` template placeholders is ignored
+//   - members only present in the new value are ignored when ignoreAdded allows it
+func jsonSemanticEqual(prior, proposed string, ignoreAdded ignoreAddedFunc) (bool, error) {
+	var priorValue, newValue any
+
+	if err := json.Unmarshal([]byte(prior), &priorValue); err != nil {
+		return false, fmt.Errorf("failed to parse prior value: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(proposed), &newValue); err != nil {
+		return false, fmt.Errorf("failed to parse new value: %w", err)
+	}
+
+	priorValue = normalizeJSONSemantics(priorValue)
+	newValue = normalizeJSONSemantics(newValue)
+
+	if ignoreAdded != nil {
+		newValue = removeIgnoredMembers(priorValue, newValue, nil, ignoreAdded)
+	}
+
+	return reflect.DeepEqual(priorValue, newValue), nil
+}
+
+// normalizeJSONSemantics removes null object members and
+// normalizes template placeholders in a decoded JSON value.
+func normalizeJSONSemantics(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		normalized := make(map[string]any, len(value))
+		for key, member := range value {
+			if member == nil {
+				continue
+			}
+
+			normalized[key] = normalizeJSONSemantics(member)
+		}
+
+		return normalized
+
+	case []any:
+		normalized := make([]any, len(value))
+		for i, element := range value {
+			normalized[i] = normalizeJSONSemantics(element)
+		}
+
+		return normalized
+
+	case string:
+		if !strings.Contains(value, "{{") {
+			return value
+		}
+
+		return templatePlaceholderRegex.ReplaceAllString(value, "{{ $1 }}")
+
+	default:
+		return value
+	}
+}
+
+// removeIgnoredMembers returns a copy of the new value without the object members
+// that are missing from the prior value and that ignoreAdded allows to ignore.
+func removeIgnoredMembers(prior, proposed any, path []string, ignoreAdded ignoreAddedFunc) any {
+	if newArray, ok := proposed.([]any); ok {
+		priorArray, ok := prior.([]any)
+		if !ok || len(priorArray) != len(newArray) {
+			return proposed
+		}
+
+		result := make([]any, len(newArray))
+		for i := range newArray {
+			result[i] = removeIgnoredMembers(priorArray[i], newArray[i], path, ignoreAdded)
+		}
+
+		return result
+	}
+
+	newObject, ok := proposed.(map[string]any)
+	if !ok {
+		return proposed
+	}
+
+	priorObject, ok := prior.(map[string]any)
+	if !ok {
+		return proposed
+	}
+
+	result := make(map[string]any, len(newObject))
+	for key, member := range newObject {
+		priorMember, exists := priorObject[key]
+		if !exists && ignoreAdded(path, key, member) {
+			continue
+		}
+
+		result[key] = removeIgnoredMembers(priorMember, member, append(path[:len(path):len(path)], key), ignoreAdded)
+	}
+
+	return result
+}
+
+// ignoreJSONSchemaKeywordDefaults allows ignoring JSON Schema keywords that
+// are set to their default value, in the schemas found under the given root member.
+func ignoreJSONSchemaKeywordDefaults(root string) ignoreAddedFunc {
+	return func(path []string, key string, value any) bool {
+		if len(path) == 0 || path[0] != root {
+			return false
+		}
+
+		// Default values and examples hold data rather than schemas.
+		for _, member := range path {
+			if member == "default" || member == "example" || member == "examples" {
+				return false
+			}
+		}
+
+		defaultValue, ok := jsonSchemaKeywordDefaults[key]
+
+		return ok && reflect.DeepEqual(defaultValue, value)
+	}
+}
+
+// semanticEqualityErrorMessage returns the message for an unexpected semantic equality check error.
+func semanticEqualityErrorMessage(err error) string {
+	return fmt.Sprintf("An unexpected error occurred while performing semantic equality checks. Please report this to the provider developers.\n\nError: %s", err.Error())
+}
@@ -0,0 +1,105 @@
+package customtypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSemanticEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		prior       string
+		proposed    string
+		ignoreAdded ignoreAddedFunc
+		expected    bool
+	}{
+		{
+			name:     "key order and whitespace",
+			prior:    `{"a": 1, "b": [1, 2]}`,
+			proposed: `{"b":[1,2],"a":1}`,
+			expected: true,
+		},
+		{
+			name:     "null members are treated as missing",
+			prior:    `{"env": {"A": "1"}}`,
+			proposed: `{"env": {"A": "1", "B": null}, "image": null}`,
+			expected: true,
+		},
+		{
+			name:     "template placeholder whitespace",
+			prior:    `{"name": "{{name}}", "command": "run {{  command}}"}`,
+			proposed: `{"name": "{{ name }}", "command": "run {{ command }}"}`,
+			expected: true,
+		},
+		{
+			name:     "template placeholder names",
+			prior:    `{"name": "{{ name }}"}`,
+			proposed: `{"name": "{{ other }}"}`,
+			expected: false,
+		},
+		{
+			name:     "array order",
+			prior:    `{"a": [1, 2]}`,
+			proposed: `{"a": [2, 1]}`,
+			expected: false,
+		},
+		{
+			name:     "added members are not ignored by default",
+			prior:    `{"a": 1}`,
+			proposed: `{"a": 1, "b": 2}`,
+			expected: false,
+		},
+		{
+			name:        "added JSON Schema keywords with their default value",
+			prior:       `{"job_configuration": {}, "variables": {"type": "object", "properties": {"image": {"type": "string"}}}}`,
+			proposed:    `{"job_configuration": {}, "variables": {"type": "object", "required": [], "properties": {"image": {"type": "string", "readOnly": false}}}}`,
+			ignoreAdded: ignoreJSONSchemaKeywordDefaults("variables"),
+			expected:    true,
+		},
+		{
+			name:        "added JSON Schema keywords with another value",
+			prior:       `{"variables": {"type": "object", "properties": {"image": {"type": "string"}}}}`,
+			proposed:    `{"variables": {"type": "object", "required": ["image"], "properties": {"image": {"type": "string"}}}}`,
+			ignoreAdded: ignoreJSONSchemaKeywordDefaults("variables"),
+			expected:    false,
+		},
+		{
+			name:        "added JSON Schema keywords outside of the variables schema",
+			prior:       `{"job_configuration": {}, "variables": {}}`,
+			proposed:    `{"job_configuration": {"required": []}, "variables": {}}`,
+			ignoreAdded: ignoreJSONSchemaKeywordDefaults("variables"),
+			expected:    false,
+		},
+		{
+			name:        "added members in default values",
+			prior:       `{"variables": {"properties": {"env": {"default": {}}}}}`,
+			proposed:    `{"variables": {"properties": {"env": {"default": {"required": []}}}}}`,
+			ignoreAdded: ignoreJSONSchemaKeywordDefaults("variables"),
+			expected:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			equal, err := jsonSemanticEqual(test.prior, test.proposed, test.ignoreAdded)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, equal)
+		})
+	}
+}
+
+func TestJSONSemanticEqual_invalidJSON(t *testing.T) {
+	t.Parallel()
+
+	_, err := jsonSemanticEqual(`{`, `{}`, nil)
+	require.Error(t, err)
+
+	_, err = jsonSemanticEqual(`{}`, `{`, nil)
+	require.Error(t, err)
+}
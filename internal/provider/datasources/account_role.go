@@ -4,10 +4,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
@@ -49,9 +47,9 @@ func (d *AccountRoleDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *AccountRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(`
-Get information about an existing Workspace Role.
+Get information about an existing Account Role.
 <br>
-Use this data source read down the pre-defined Roles, to manage User and Service Account access.
+Use this data source to read down the pre-defined Roles, or custom Roles managed with the `+"`prefect_account_role`"+` resource, to manage User and Service Account access.
 <br>
 For more information, see [manage account roles](https://docs.prefect.io/v3/manage/cloud/manage-users/manage-roles#manage-account-roles).
 `,
@@ -75,10 +73,7 @@ For more information, see [manage account roles](https://docs.prefect.io/v3/mana
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "Name of the Account Role",
-				Validators: []validator.String{
-					stringvalidator.OneOf("Admin", "Member", "Owner"),
-				},
+				Description: "Name of the Account Role, either a default role (`Admin`, `Member` or `Owner`) or a custom role",
 			},
 			"permissions": schema.ListAttribute{
 				Computed:    true,
@@ -0,0 +1,302 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&AutomationsDataSource{})
+
+// AutomationsDataSource contains state for the data source.
+type AutomationsDataSource struct {
+	client api.PrefectClient
+}
+
+// AutomationsDataSourceModel defines the Terraform data source model.
+type AutomationsDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Name              types.String `tfsdk:"name"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	RelatedResourceID types.String `tfsdk:"related_resource_id"`
+
+	Automations []AutomationsAutomationModel `tfsdk:"automations"`
+}
+
+// AutomationsAutomationModel defines a summary of a single automation returned by the data source.
+type AutomationsAutomationModel struct {
+	BaseModel
+
+	Name             types.String             `tfsdk:"name"`
+	Description      types.String             `tfsdk:"description"`
+	Enabled          types.Bool               `tfsdk:"enabled"`
+	TriggerType      types.String             `tfsdk:"trigger_type"`
+	Actions          []AutomationsActionModel `tfsdk:"actions"`
+	ActionsOnTrigger []AutomationsActionModel `tfsdk:"actions_on_trigger"`
+	ActionsOnResolve []AutomationsActionModel `tfsdk:"actions_on_resolve"`
+}
+
+// AutomationsActionModel defines a summary of a single automation action,
+// limited to its type and the resources it targets.
+type AutomationsActionModel struct {
+	Type            types.String          `tfsdk:"type"`
+	Source          types.String          `tfsdk:"source"`
+	AutomationID    customtypes.UUIDValue `tfsdk:"automation_id"`
+	BlockDocumentID customtypes.UUIDValue `tfsdk:"block_document_id"`
+	DeploymentID    customtypes.UUIDValue `tfsdk:"deployment_id"`
+	WorkPoolID      customtypes.UUIDValue `tfsdk:"work_pool_id"`
+	WorkQueueID     customtypes.UUIDValue `tfsdk:"work_queue_id"`
+}
+
+// NewAutomationsDataSource returns a new AutomationsDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAutomationsDataSource() datasource.DataSource {
+	return &AutomationsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *AutomationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automations"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *AutomationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+var automationsActionAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Computed:    true,
+		Description: "Type of the action",
+	},
+	"source": schema.StringAttribute{
+		Computed:    true,
+		Description: "Whether the action's target is `selected` explicitly, or `inferred` from the triggering event",
+	},
+	"automation_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "ID of the automation the action targets",
+	},
+	"block_document_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "ID of the block document the action uses",
+	},
+	"deployment_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "ID of the deployment the action targets",
+	},
+	"work_pool_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "ID of the work pool the action targets",
+	},
+	"work_queue_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "ID of the work queue the action targets",
+	},
+}
+
+// Schema defines the schema for the data source.
+func (d *AutomationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get a summary of multiple Automations.
+<br>
+Use this data source to search for Automations by name, enabled state or related resource,
+for example to audit which Automations target a Deployment before deleting it.
+All filters are optional and combined with AND. Defaults to fetching all Automations in the Workspace.
+<br>
+Each Automation is summarized by its trigger type and the resources its actions target.
+Use the `+"`prefect_automation`"+` data source to read the full configuration of a single Automation.
+<br>
+For more information, see [automations](https://docs.prefect.io/v3/automate/events/automations-triggers).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return automations with this name",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return automations with this enabled state",
+				Optional:    true,
+			},
+			"related_resource_id": schema.StringAttribute{
+				Description: "Only return automations related to this resource, for example `prefect.deployment.<deployment-id>` or `prefect.block-document.<block-id>`",
+				Optional:    true,
+			},
+			"automations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Automations returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Automation ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the automation",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the automation",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the automation is enabled",
+						},
+						"trigger_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the automation's trigger: `event`, `metric`, `compound` or `sequence`",
+						},
+						"actions": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Actions to perform when the automation is triggered",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: automationsActionAttributes,
+							},
+						},
+						"actions_on_trigger": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Actions to perform when the automation's trigger fires",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: automationsActionAttributes,
+							},
+						},
+						"actions_on_resolve": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Actions to perform when the automation's trigger resolves",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: automationsActionAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AutomationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model AutomationsDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Automations(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automations", err))
+
+		return
+	}
+
+	filter := api.AutomationFilter{
+		Enabled:           model.Enabled.ValueBoolPointer(),
+		RelatedResourceID: model.RelatedResourceID.ValueString(),
+	}
+
+	if !model.Name.IsNull() {
+		filter.Names = []string{model.Name.ValueString()}
+	}
+
+	automations, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Automations", "list", err))
+
+		return
+	}
+
+	model.Automations = make([]AutomationsAutomationModel, 0, len(automations))
+	for _, automation := range automations {
+		model.Automations = append(model.Automations, AutomationsAutomationModel{
+			BaseModel: BaseModel{
+				ID:      customtypes.NewUUIDValue(automation.ID),
+				Created: customtypes.NewTimestampPointerValue(automation.Created),
+				Updated: customtypes.NewTimestampPointerValue(automation.Updated),
+			},
+			Name:             types.StringValue(automation.Name),
+			Description:      types.StringValue(automation.Description),
+			Enabled:          types.BoolValue(automation.Enabled),
+			TriggerType:      types.StringValue(automation.Trigger.Type),
+			Actions:          automationsActionModelsFromAPI(automation.Actions),
+			ActionsOnTrigger: automationsActionModelsFromAPI(automation.ActionsOnTrigger),
+			ActionsOnResolve: automationsActionModelsFromAPI(automation.ActionsOnResolve),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// automationsActionModelsFromAPI summarizes the given actions.
+func automationsActionModelsFromAPI(actions []api.Action) []AutomationsActionModel {
+	models := make([]AutomationsActionModel, 0, len(actions))
+	for i := range actions {
+		action := actions[i]
+
+		models = append(models, AutomationsActionModel{
+			Type:            types.StringValue(action.Type),
+			Source:          types.StringPointerValue(action.Source),
+			AutomationID:    customtypes.NewUUIDPointerValue(action.AutomationID),
+			BlockDocumentID: customtypes.NewUUIDPointerValue(action.BlockDocumentID),
+			DeploymentID:    customtypes.NewUUIDPointerValue(action.DeploymentID),
+			WorkPoolID:      customtypes.NewUUIDPointerValue(action.WorkPoolID),
+			WorkQueueID:     customtypes.NewUUIDPointerValue(action.WorkQueueID),
+		})
+	}
+
+	return models
+}
@@ -0,0 +1,136 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type automationsFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+	Deployment     string
+	EnabledName    string
+	DisabledName   string
+}
+
+func fixtureAccAutomations(cfg automationsFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+{{ .Deployment }}
+
+resource "prefect_automation" "enabled" {
+	name    = "{{ .EnabledName }}"
+	enabled = true
+
+	trigger = {
+		event = {
+			posture   = "Reactive"
+			expect    = ["prefect.flow-run.Failed"]
+			threshold = 1
+			within    = 60
+		}
+	}
+
+	actions = [
+		{
+			type          = "run-deployment"
+			source        = "selected"
+			deployment_id = prefect_deployment.test_deployment.id
+		},
+	]
+
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_automation" "disabled" {
+	name    = "{{ .DisabledName }}"
+	enabled = false
+
+	trigger = {
+		event = {
+			posture   = "Reactive"
+			expect    = ["prefect.flow-run.Crashed"]
+			threshold = 1
+			within    = 60
+		}
+	}
+
+	actions = [
+		{
+			type = "do-nothing"
+		},
+	]
+
+	{{ .WorkspaceIDArg }}
+}
+
+data "prefect_automations" "related_to_deployment" {
+	related_resource_id = "prefect.deployment.${prefect_deployment.test_deployment.id}"
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_automation.enabled, prefect_automation.disabled]
+}
+
+data "prefect_automations" "disabled_by_name" {
+	name    = "{{ .DisabledName }}"
+	enabled = false
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_automation.enabled, prefect_automation.disabled]
+}
+
+data "prefect_automations" "enabled_by_name" {
+	name    = "{{ .DisabledName }}"
+	enabled = true
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_automation.enabled, prefect_automation.disabled]
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_automations(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := automationsFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+		Deployment:     testutils.FixtureAccAutomationDeployment(workspace.IDArg),
+		EnabledName:    testutils.NewRandomPrefixedString(),
+		DisabledName:   testutils.NewRandomPrefixedString(),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccAutomations(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize("data.prefect_automations.related_to_deployment", "automations", 1),
+					testutils.ExpectKnownValue("data.prefect_automations.related_to_deployment", "automations.0.name", cfg.EnabledName),
+					testutils.ExpectKnownValue("data.prefect_automations.related_to_deployment", "automations.0.trigger_type", "event"),
+					testutils.ExpectKnownValue("data.prefect_automations.related_to_deployment", "automations.0.actions.0.type", "run-deployment"),
+					testutils.ExpectKnownValueNotNull("data.prefect_automations.related_to_deployment", "automations.0.actions.0.deployment_id"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_automations.disabled_by_name", "automations", 1),
+					testutils.ExpectKnownValueBool("data.prefect_automations.disabled_by_name", "automations.0.enabled", false),
+					testutils.ExpectKnownValue("data.prefect_automations.disabled_by_name", "automations.0.actions.0.type", "do-nothing"),
+					testutils.ExpectKnownValueNull("data.prefect_automations.disabled_by_name", "automations.0.actions.0.deployment_id"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_automations.enabled_by_name", "automations", 0),
+				},
+			},
+		},
+	})
+}
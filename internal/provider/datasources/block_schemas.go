@@ -0,0 +1,222 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlockSchemasDataSource{})
+
+// BlockSchemasDataSource contains state for the data source.
+type BlockSchemasDataSource struct {
+	client api.PrefectClient
+}
+
+// BlockSchemasDataSourceModel defines the Terraform data source model.
+type BlockSchemasDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	TypeSlug    types.String          `tfsdk:"type_slug"`
+	BlockTypeID customtypes.UUIDValue `tfsdk:"block_type_id"`
+
+	BlockSchemas []BlockSchemaModel `tfsdk:"block_schemas"`
+}
+
+// BlockSchemaModel defines a single block schema returned by the data source.
+type BlockSchemaModel struct {
+	BaseModel
+
+	Capabilities []types.String       `tfsdk:"capabilities"`
+	Checksum     types.String         `tfsdk:"checksum"`
+	Fields       jsontypes.Normalized `tfsdk:"fields"`
+	Version      types.String         `tfsdk:"version"`
+}
+
+// NewBlockSchemasDataSource returns a new BlockSchemasDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockSchemasDataSource() datasource.DataSource {
+	return &BlockSchemasDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlockSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_schemas"
+}
+
+// Schema defines the schema for the data source.
+func (d *BlockSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about all Block Schemas of a Block Type.
+<br>
+Use this data source to inspect the versions, capabilities and fields of a Block Type's schemas.
+<br>
+For more information, see [securely store typed configuration](https://docs.prefect.io/v3/develop/blocks).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"type_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Block Type slug, for example `aws-credentials`",
+			},
+			"block_type_id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Type ID (UUID)",
+			},
+			"block_schemas": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Block Schemas of the Block Type returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block Schema ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"capabilities": schema.ListAttribute{
+							Computed:    true,
+							Description: "Capabilities of the Block Schema, for example `read-path` or `write-path`",
+							ElementType: types.StringType,
+						},
+						"checksum": schema.StringAttribute{
+							Computed:    true,
+							Description: "Checksum of the Block Schema's fields",
+						},
+						"fields": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "The Block Schema's fields, as a JSON Schema string",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Version of the Block Schema",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlockSchemasDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlockSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlockSchemasDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blockTypeClient, err := d.client.BlockTypes(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
+
+		return
+	}
+
+	blockSchemaClient, err := d.client.BlockSchemas(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
+
+		return
+	}
+
+	blockType, err := blockTypeClient.GetBySlug(ctx, model.TypeSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Type", "get", err))
+
+		return
+	}
+
+	blockSchemas, err := blockSchemaClient.List(ctx, []uuid.UUID{blockType.ID})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Schemas", "list", err))
+
+		return
+	}
+
+	model.BlockTypeID = customtypes.NewUUIDValue(blockType.ID)
+	model.BlockSchemas = make([]BlockSchemaModel, 0, len(blockSchemas))
+
+	for _, blockSchema := range blockSchemas {
+		fieldsByteSlice, err := json.Marshal(blockSchema.Fields)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("fields", "Block Schema fields", err))
+
+			return
+		}
+
+		capabilities := make([]types.String, 0, len(blockSchema.Capabilities))
+		for _, capability := range blockSchema.Capabilities {
+			capabilities = append(capabilities, types.StringValue(capability))
+		}
+
+		model.BlockSchemas = append(model.BlockSchemas, BlockSchemaModel{
+			BaseModel: BaseModel{
+				ID:      customtypes.NewUUIDValue(blockSchema.ID),
+				Created: customtypes.NewTimestampPointerValue(blockSchema.Created),
+				Updated: customtypes.NewTimestampPointerValue(blockSchema.Updated),
+			},
+			Capabilities: capabilities,
+			Checksum:     types.StringValue(blockSchema.Checksum),
+			Fields:       jsontypes.NewNormalizedValue(string(fieldsByteSlice)),
+			Version:      types.StringValue(blockSchema.Version),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
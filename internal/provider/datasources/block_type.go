@@ -0,0 +1,176 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlockTypeDataSource{})
+
+// BlockTypeDataSource contains state for the data source.
+type BlockTypeDataSource struct {
+	client api.PrefectClient
+}
+
+// BlockTypeDataSourceModel defines the Terraform data source model.
+type BlockTypeDataSourceModel struct {
+	BaseModel
+
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Slug             types.String `tfsdk:"slug"`
+	Name             types.String `tfsdk:"name"`
+	LogoURL          types.String `tfsdk:"logo_url"`
+	DocumentationURL types.String `tfsdk:"documentation_url"`
+	Description      types.String `tfsdk:"description"`
+	CodeExample      types.String `tfsdk:"code_example"`
+	IsProtected      types.Bool   `tfsdk:"is_protected"`
+}
+
+// NewBlockTypeDataSource returns a new BlockTypeDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockTypeDataSource() datasource.DataSource {
+	return &BlockTypeDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlockTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_type"
+}
+
+// Schema defines the schema for the data source.
+func (d *BlockTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about an existing Block Type by its slug.
+<br>
+Use this data source to obtain the ID of a Block Type, for example to look up its schemas or documents.
+<br>
+For more information, see [securely store typed configuration](https://docs.prefect.io/v3/develop/blocks).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Block Type ID (UUID)",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Block Type slug, for example `aws-credentials`. Use `prefect block type ls` to view all available Block Type slugs.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the Block Type",
+			},
+			"logo_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Web URL for the Block Type's logo",
+			},
+			"documentation_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Web URL for the Block Type's documentation",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "A short blurb about the corresponding Block's intended use",
+			},
+			"code_example": schema.StringAttribute{
+				Computed:    true,
+				Description: "A code snippet demonstrating use of the corresponding Block",
+			},
+			"is_protected": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Block Type is protected. Protected Block Types are provided by Prefect and cannot be modified.",
+			},
+		},
+	}
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlockTypeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlockTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlockTypeDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.BlockTypes(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
+
+		return
+	}
+
+	blockType, err := client.GetBySlug(ctx, model.Slug.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Type", "get", err))
+
+		return
+	}
+
+	model.ID = customtypes.NewUUIDValue(blockType.ID)
+	model.Created = customtypes.NewTimestampPointerValue(blockType.Created)
+	model.Updated = customtypes.NewTimestampPointerValue(blockType.Updated)
+
+	model.Slug = types.StringValue(blockType.Slug)
+	model.Name = types.StringValue(blockType.Name)
+	model.LogoURL = types.StringValue(blockType.LogoURL)
+	model.DocumentationURL = types.StringValue(blockType.DocumentationURL)
+	model.Description = types.StringValue(blockType.Description)
+	model.CodeExample = types.StringValue(blockType.CodeExample)
+	model.IsProtected = types.BoolValue(blockType.IsProtected)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
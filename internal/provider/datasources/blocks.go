@@ -0,0 +1,221 @@
+package datasources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&BlocksDataSource{})
+
+// BlocksDataSource contains state for the data source.
+type BlocksDataSource struct {
+	client api.PrefectClient
+}
+
+// BlocksDataSourceModel defines the Terraform data source model.
+type BlocksDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	TypeSlug   types.String `tfsdk:"type_slug"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+
+	Blocks []BlocksBlockModel `tfsdk:"blocks"`
+}
+
+// BlocksBlockModel defines a single block returned by the data source.
+type BlocksBlockModel struct {
+	BaseModel
+
+	Name          types.String          `tfsdk:"name"`
+	TypeSlug      types.String          `tfsdk:"type_slug"`
+	BlockTypeID   customtypes.UUIDValue `tfsdk:"block_type_id"`
+	BlockSchemaID customtypes.UUIDValue `tfsdk:"block_schema_id"`
+}
+
+// NewBlocksDataSource returns a new BlocksDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlocksDataSource() datasource.DataSource {
+	return &BlocksDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *BlocksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocks"
+}
+
+// Schema defines the schema for the data source.
+func (d *BlocksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about all Blocks of a Block Type.
+<br>
+Use this data source to enumerate Blocks, optionally narrowed down by a name prefix,
+for example to manage access to every Block of a given type with `+"`prefect_block_access`"+`.
+<br>
+Block data is not included. Use the `+"`prefect_block`"+` data source to read the data of a single Block.
+<br>
+For more information, see [securely store typed configuration](https://docs.prefect.io/v3/develop/blocks).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"type_slug": schema.StringAttribute{
+				Required:    true,
+				Description: "Block Type slug, for example `aws-credentials`",
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return blocks whose name starts with this value",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"blocks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Blocks returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the block",
+						},
+						"type_slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "Block type slug",
+						},
+						"block_type_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block Type ID (UUID)",
+						},
+						"block_schema_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block Schema ID (UUID)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure initializes runtime state for the data source.
+func (d *BlocksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BlocksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model BlocksDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.BlockDocuments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return
+	}
+
+	// Anonymous blocks are created implicitly by Prefect and
+	// are not meant to be referenced, so they are left out.
+	isAnonymous := false
+	filter := api.BlockDocumentFilter{
+		BlockDocuments: &api.BlockDocumentFilterBlockDocuments{
+			IsAnonymous: &api.BlockDocumentFilterIsAnonymous{Eq: &isAnonymous},
+		},
+		BlockTypes: &api.BlockDocumentFilterBlockTypes{
+			Slug: &api.BlockDocumentFilterSlug{Any: []string{model.TypeSlug.ValueString()}},
+		},
+	}
+
+	if !model.NamePrefix.IsNull() {
+		filter.BlockDocuments.Name = &api.BlockDocumentFilterName{Like: model.NamePrefix.ValueStringPointer()}
+	}
+
+	blocks, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Blocks", "list", err))
+
+		return
+	}
+
+	model.Blocks = make([]BlocksBlockModel, 0, len(blocks))
+	for _, block := range blocks {
+		// The API only supports a partial name match, so narrow
+		// the results down to the blocks that start with the prefix.
+		if !model.NamePrefix.IsNull() && !strings.HasPrefix(block.Name, model.NamePrefix.ValueString()) {
+			continue
+		}
+
+		model.Blocks = append(model.Blocks, BlocksBlockModel{
+			BaseModel: BaseModel{
+				ID:      customtypes.NewUUIDValue(block.ID),
+				Created: customtypes.NewTimestampPointerValue(block.Created),
+				Updated: customtypes.NewTimestampPointerValue(block.Updated),
+			},
+			Name:          types.StringValue(block.Name),
+			TypeSlug:      types.StringValue(block.BlockType.Slug),
+			BlockTypeID:   customtypes.NewUUIDValue(block.BlockTypeID),
+			BlockSchemaID: customtypes.NewUUIDValue(block.BlockSchemaID),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
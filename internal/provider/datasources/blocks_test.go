@@ -0,0 +1,113 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type blocksFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+}
+
+func fixtureAccBlocks(cfg blocksFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_block" "prod_b" {
+	name      = "prod-b"
+	type_slug = "secret"
+	data      = jsonencode({ "value" = "b" })
+
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_block" "prod_a" {
+	name      = "prod-a"
+	type_slug = "secret"
+	data      = jsonencode({ "value" = "a" })
+
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_block" "dev" {
+	name      = "dev-prod"
+	type_slug = "secret"
+	data      = jsonencode({ "value" = "dev" })
+
+	{{ .WorkspaceIDArg }}
+}
+
+data "prefect_block_type" "secret" {
+	slug = "secret"
+
+	{{ .WorkspaceIDArg }}
+}
+
+data "prefect_block_schemas" "secret" {
+	type_slug = data.prefect_block_type.secret.slug
+
+	{{ .WorkspaceIDArg }}
+}
+
+data "prefect_blocks" "all" {
+	type_slug = "secret"
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_block.prod_a, prefect_block.prod_b, prefect_block.dev]
+}
+
+data "prefect_blocks" "prod" {
+	type_slug   = "secret"
+	name_prefix = "prod-"
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_block.prod_a, prefect_block.prod_b, prefect_block.dev]
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_blocks(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := blocksFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccBlocks(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNotNull("data.prefect_block_type.secret", "id"),
+					testutils.ExpectKnownValue("data.prefect_block_type.secret", "name", "Secret"),
+					testutils.ExpectKnownValueBool("data.prefect_block_type.secret", "is_protected", true),
+
+					testutils.ExpectKnownValueNotNull("data.prefect_block_schemas.secret", "block_type_id"),
+					testutils.ExpectKnownValueListSizeMin("data.prefect_block_schemas.secret", "block_schemas", 1),
+					testutils.ExpectKnownValueNotNull("data.prefect_block_schemas.secret", "block_schemas.0.fields"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_blocks.all", "blocks", 3),
+
+					// Blocks are sorted by name, and only those starting
+					// with the prefix are returned.
+					testutils.ExpectKnownValueListSize("data.prefect_blocks.prod", "blocks", 2),
+					testutils.ExpectKnownValue("data.prefect_blocks.prod", "blocks.0.name", "prod-a"),
+					testutils.ExpectKnownValue("data.prefect_blocks.prod", "blocks.1.name", "prod-b"),
+					testutils.ExpectKnownValue("data.prefect_blocks.prod", "blocks.0.type_slug", "secret"),
+				},
+			},
+		},
+	})
+}
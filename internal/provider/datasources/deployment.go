@@ -124,7 +124,7 @@ For more information, see [deploy overview](https://docs.prefect.io/v3/deploy/in
 			},
 			"job_variables": schema.StringAttribute{
 				Computed:    true,
-				CustomType:  jsontypes.NormalizedType{},
+				CustomType:  customtypes.JobVariablesType{},
 				Description: "Overrides for the flow's infrastructure configuration.",
 			},
 			"work_queue_name": schema.StringAttribute{
@@ -335,7 +335,7 @@ func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if err != nil {
 		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("job_variables", "Deployment job variables", err))
 	}
-	model.JobVariables = jsontypes.NewNormalizedValue(string(jobVariablesByteSlice))
+	model.JobVariables = customtypes.NewJobVariablesValue(string(jobVariablesByteSlice))
 
 	parameterOpenAPISchemaByteSlice, err := json.Marshal(deployment.ParameterOpenAPISchema)
 	if err != nil {
@@ -0,0 +1,521 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&DeploymentsDataSource{})
+
+// DeploymentsDataSource contains state for the data source.
+type DeploymentsDataSource struct {
+	client api.PrefectClient
+}
+
+// DeploymentsDataSourceModel defines the Terraform data source model.
+type DeploymentsDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	FlowName      types.String `tfsdk:"flow_name"`
+	NameLike      types.String `tfsdk:"name_like"`
+	Paused        types.Bool   `tfsdk:"paused"`
+	TagsAll       types.Set    `tfsdk:"tags_all"`
+	TagsAny       types.Set    `tfsdk:"tags_any"`
+	WorkPoolName  types.String `tfsdk:"work_pool_name"`
+	WorkQueueName types.String `tfsdk:"work_queue_name"`
+
+	Deployments []DeploymentsDeploymentModel `tfsdk:"deployments"`
+}
+
+// DeploymentsDeploymentModel defines a single deployment returned by the data source.
+type DeploymentsDeploymentModel struct {
+	ID      customtypes.UUIDValue      `tfsdk:"id"`
+	Created customtypes.TimestampValue `tfsdk:"created"`
+	Updated customtypes.TimestampValue `tfsdk:"updated"`
+
+	ConcurrencyLimit         types.Int64                    `tfsdk:"concurrency_limit"`
+	ConcurrencyOptions       *DeploymentsConcurrencyOptions `tfsdk:"concurrency_options"`
+	Description              types.String                   `tfsdk:"description"`
+	Entrypoint               types.String                   `tfsdk:"entrypoint"`
+	FlowID                   customtypes.UUIDValue          `tfsdk:"flow_id"`
+	GlobalConcurrencyLimitID customtypes.UUIDValue          `tfsdk:"global_concurrency_limit_id"`
+	JobVariables             jsontypes.Normalized           `tfsdk:"job_variables"`
+	Name                     types.String                   `tfsdk:"name"`
+	Parameters               jsontypes.Normalized           `tfsdk:"parameters"`
+	Path                     types.String                   `tfsdk:"path"`
+	Paused                   types.Bool                     `tfsdk:"paused"`
+	Schedules                []DeploymentsScheduleModel     `tfsdk:"schedules"`
+	Tags                     []types.String                 `tfsdk:"tags"`
+	Version                  types.String                   `tfsdk:"version"`
+	WorkPoolName             types.String                   `tfsdk:"work_pool_name"`
+	WorkQueueName            types.String                   `tfsdk:"work_queue_name"`
+}
+
+// DeploymentsConcurrencyOptions defines the concurrency options of a deployment.
+type DeploymentsConcurrencyOptions struct {
+	CollisionStrategy types.String `tfsdk:"collision_strategy"`
+}
+
+// DeploymentsScheduleModel defines a single schedule of a deployment.
+type DeploymentsScheduleModel struct {
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	Active           types.Bool           `tfsdk:"active"`
+	AnchorDate       types.String         `tfsdk:"anchor_date"`
+	Cron             types.String         `tfsdk:"cron"`
+	DayOr            types.Bool           `tfsdk:"day_or"`
+	Interval         types.Float32        `tfsdk:"interval"`
+	MaxScheduledRuns types.Float32        `tfsdk:"max_scheduled_runs"`
+	Parameters       jsontypes.Normalized `tfsdk:"parameters"`
+	RRule            types.String         `tfsdk:"rrule"`
+	Slug             types.String         `tfsdk:"slug"`
+	Timezone         types.String         `tfsdk:"timezone"`
+}
+
+// NewDeploymentsDataSource returns a new DeploymentsDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentsDataSource() datasource.DataSource {
+	return &DeploymentsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DeploymentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployments"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *DeploymentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *DeploymentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about multiple Deployments.
+<br>
+Use this data source to search for Deployments by flow, tags, work pool, work queue, paused state or name.
+All filters are optional and combined with AND. Defaults to fetching all Deployments in the Workspace.
+<br>
+For more information, see [deploy overview](https://docs.prefect.io/v3/deploy/index).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"flow_name": schema.StringAttribute{
+				Description: "Only return deployments of the flow with this name",
+				Optional:    true,
+			},
+			"name_like": schema.StringAttribute{
+				Description: "Only return deployments whose name contains this value (case-insensitive)",
+				Optional:    true,
+			},
+			"paused": schema.BoolAttribute{
+				Description: "Only return deployments with this paused state",
+				Optional:    true,
+			},
+			"tags_all": schema.SetAttribute{
+				Description: "Only return deployments that have all of these tags",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"tags_any": schema.SetAttribute{
+				Description: "Only return deployments that have at least one of these tags",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"work_pool_name": schema.StringAttribute{
+				Description: "Only return deployments that run on the work pool with this name",
+				Optional:    true,
+			},
+			"work_queue_name": schema.StringAttribute{
+				Description: "Only return deployments that use the work queue with this name",
+				Optional:    true,
+			},
+			"deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Deployments returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: deploymentsDeploymentAttributes,
+				},
+			},
+		},
+	}
+}
+
+var deploymentsDeploymentAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Deployment ID (UUID)",
+	},
+	"created": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Timestamp of when the resource was created (RFC3339)",
+	},
+	"updated": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Timestamp of when the resource was updated (RFC3339)",
+	},
+	"name": schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the deployment",
+	},
+	"flow_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Flow ID (UUID) the deployment belongs to",
+	},
+	"description": schema.StringAttribute{
+		Computed:    true,
+		Description: "A description for the deployment.",
+	},
+	"entrypoint": schema.StringAttribute{
+		Computed:    true,
+		Description: "The path to the entrypoint for the workflow, relative to the path.",
+	},
+	"path": schema.StringAttribute{
+		Computed:    true,
+		Description: "The path to the working directory for the workflow, relative to remote storage or an absolute path.",
+	},
+	"version": schema.StringAttribute{
+		Computed:    true,
+		Description: "An optional version for the deployment.",
+	},
+	"tags": schema.ListAttribute{
+		Computed:    true,
+		Description: "Tags associated with the deployment",
+		ElementType: types.StringType,
+	},
+	"paused": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether or not the deployment is paused.",
+	},
+	"work_pool_name": schema.StringAttribute{
+		Computed:    true,
+		Description: "The name of the deployment's work pool.",
+	},
+	"work_queue_name": schema.StringAttribute{
+		Computed:    true,
+		Description: "The work queue for the deployment.",
+	},
+	"parameters": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  jsontypes.NormalizedType{},
+		Description: "Parameters for flow runs scheduled by the deployment.",
+	},
+	"job_variables": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  jsontypes.NormalizedType{},
+		Description: "Overrides for the flow's infrastructure configuration.",
+	},
+	"concurrency_limit": schema.Int64Attribute{
+		Computed:    true,
+		Description: "The deployment's concurrency limit.",
+	},
+	"global_concurrency_limit_id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "The ID of the global concurrency limit backing the deployment's concurrency limit.",
+	},
+	"concurrency_options": schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Concurrency options for the deployment.",
+		Attributes: map[string]schema.Attribute{
+			"collision_strategy": schema.StringAttribute{
+				Computed:    true,
+				Description: "Enumeration of concurrency collision strategies.",
+			},
+		},
+	},
+	"schedules": schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "Schedules of the deployment",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					CustomType:  customtypes.UUIDType{},
+					Description: "Deployment Schedule ID (UUID)",
+				},
+				"slug": schema.StringAttribute{
+					Computed:    true,
+					Description: "An optional unique identifier for the schedule.",
+				},
+				"active": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether or not the schedule is active.",
+				},
+				"max_scheduled_runs": schema.Float32Attribute{
+					Computed:    true,
+					Description: "The maximum number of scheduled runs for the schedule.",
+				},
+				"parameters": schema.StringAttribute{
+					Computed:    true,
+					CustomType:  jsontypes.NormalizedType{},
+					Description: "Parameters for flow runs scheduled by the deployment schedule.",
+				},
+				"timezone": schema.StringAttribute{
+					Computed:    true,
+					Description: "The timezone of the schedule.",
+				},
+				"interval": schema.Float32Attribute{
+					Computed:    true,
+					Description: "The interval of the schedule.",
+				},
+				"anchor_date": schema.StringAttribute{
+					Computed:    true,
+					Description: "The anchor date of the schedule.",
+				},
+				"cron": schema.StringAttribute{
+					Computed:    true,
+					Description: "The cron expression of the schedule.",
+				},
+				"day_or": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Control croniter behavior for handling day and day_of_week entries.",
+				},
+				"rrule": schema.StringAttribute{
+					Computed:    true,
+					Description: "The rrule expression of the schedule.",
+				},
+			},
+		},
+	},
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model DeploymentsDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment", err))
+
+		return
+	}
+
+	filter, diags := deploymentsFilterFromModel(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployments, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployments", "list", err))
+
+		return
+	}
+
+	model.Deployments = make([]DeploymentsDeploymentModel, 0, len(deployments))
+	for _, deployment := range deployments {
+		deploymentModel, diags := deploymentsDeploymentModelFromAPI(deployment)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		model.Deployments = append(model.Deployments, deploymentModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// deploymentsFilterFromModel builds the API filter from the configured filter attributes.
+// Filters that are not configured are left out of the request.
+func deploymentsFilterFromModel(ctx context.Context, model DeploymentsDataSourceModel) (api.DeploymentFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter api.DeploymentFilter
+
+	deployments := api.DeploymentFilterDeployments{}
+	hasDeploymentFilter := false
+
+	if !model.NameLike.IsNull() {
+		deployments.Name = &api.DeploymentFilterName{Like: model.NameLike.ValueStringPointer()}
+		hasDeploymentFilter = true
+	}
+
+	if !model.Paused.IsNull() {
+		deployments.Paused = &api.DeploymentFilterPaused{Eq: model.Paused.ValueBoolPointer()}
+		hasDeploymentFilter = true
+	}
+
+	if !model.TagsAll.IsNull() || !model.TagsAny.IsNull() {
+		tags := api.DeploymentFilterTags{}
+		diags.Append(model.TagsAll.ElementsAs(ctx, &tags.All, false)...)
+		diags.Append(model.TagsAny.ElementsAs(ctx, &tags.Any, false)...)
+		if diags.HasError() {
+			return filter, diags
+		}
+
+		deployments.Tags = &tags
+		hasDeploymentFilter = true
+	}
+
+	if !model.WorkQueueName.IsNull() {
+		deployments.WorkQueueName = &api.DeploymentFilterName{Any: []string{model.WorkQueueName.ValueString()}}
+		hasDeploymentFilter = true
+	}
+
+	if hasDeploymentFilter {
+		filter.Deployments = &deployments
+	}
+
+	if !model.FlowName.IsNull() {
+		filter.Flows = &api.DeploymentFilterFlows{
+			Name: &api.DeploymentFilterName{Any: []string{model.FlowName.ValueString()}},
+		}
+	}
+
+	if !model.WorkPoolName.IsNull() {
+		filter.WorkPools = &api.DeploymentFilterWorkPools{
+			Name: &api.DeploymentFilterName{Any: []string{model.WorkPoolName.ValueString()}},
+		}
+	}
+
+	return filter, diags
+}
+
+// deploymentsDeploymentModelFromAPI maps a deployment returned by the API onto a DeploymentsDeploymentModel.
+func deploymentsDeploymentModelFromAPI(deployment *api.Deployment) (DeploymentsDeploymentModel, diag.Diagnostics) {
+	model := DeploymentsDeploymentModel{
+		ID:                       customtypes.NewUUIDValue(deployment.ID),
+		Created:                  customtypes.NewTimestampPointerValue(deployment.Created),
+		Updated:                  customtypes.NewTimestampPointerValue(deployment.Updated),
+		ConcurrencyLimit:         types.Int64Null(),
+		Description:              types.StringValue(deployment.Description),
+		Entrypoint:               types.StringValue(deployment.Entrypoint),
+		FlowID:                   customtypes.NewUUIDValue(deployment.FlowID),
+		GlobalConcurrencyLimitID: customtypes.NewUUIDNull(),
+		Name:                     types.StringValue(deployment.Name),
+		Path:                     types.StringValue(deployment.Path),
+		Paused:                   types.BoolValue(deployment.Paused),
+		Version:                  types.StringValue(deployment.Version),
+		WorkPoolName:             types.StringValue(deployment.WorkPoolName),
+		WorkQueueName:            types.StringValue(deployment.WorkQueueName),
+	}
+
+	// The concurrency_limit field in the response payload is deprecated, and will always be 0
+	// for compatibility. The true value has been moved under `global_concurrency_limit.limit`.
+	if deployment.GlobalConcurrencyLimit != nil {
+		model.ConcurrencyLimit = types.Int64Value(deployment.GlobalConcurrencyLimit.Limit)
+		model.GlobalConcurrencyLimitID = customtypes.NewUUIDValue(deployment.GlobalConcurrencyLimit.ID)
+	}
+
+	if deployment.ConcurrencyOptions != nil {
+		model.ConcurrencyOptions = &DeploymentsConcurrencyOptions{
+			CollisionStrategy: types.StringValue(deployment.ConcurrencyOptions.CollisionStrategy),
+		}
+	}
+
+	model.Tags = make([]types.String, 0, len(deployment.Tags))
+	for _, tag := range deployment.Tags {
+		model.Tags = append(model.Tags, types.StringValue(tag))
+	}
+
+	parameters, diags := normalizedJSONValue("parameters", "Deployment parameters", deployment.Parameters)
+	if diags.HasError() {
+		return model, diags
+	}
+	model.Parameters = parameters
+
+	jobVariables, diags := normalizedJSONValue("job_variables", "Deployment job variables", deployment.JobVariables)
+	if diags.HasError() {
+		return model, diags
+	}
+	model.JobVariables = jobVariables
+
+	model.Schedules = make([]DeploymentsScheduleModel, 0, len(deployment.Schedules))
+	for i := range deployment.Schedules {
+		schedule := deployment.Schedules[i]
+
+		scheduleParameters, diags := normalizedJSONValue("parameters", "Deployment Schedule parameters", schedule.Parameters)
+		if diags.HasError() {
+			return model, diags
+		}
+
+		model.Schedules = append(model.Schedules, DeploymentsScheduleModel{
+			ID:               customtypes.NewUUIDValue(schedule.ID),
+			Active:           types.BoolPointerValue(schedule.Active),
+			AnchorDate:       types.StringValue(schedule.Schedule.AnchorDate),
+			Cron:             types.StringValue(schedule.Schedule.Cron),
+			DayOr:            types.BoolPointerValue(schedule.Schedule.DayOr),
+			Interval:         types.Float32Value(schedule.Schedule.Interval),
+			MaxScheduledRuns: types.Float32Value(schedule.MaxScheduledRuns),
+			Parameters:       scheduleParameters,
+			RRule:            types.StringValue(schedule.Schedule.RRule),
+			Slug:             types.StringValue(schedule.Slug),
+			Timezone:         types.StringValue(schedule.Schedule.Timezone),
+		})
+	}
+
+	return model, nil
+}
+
+// normalizedJSONValue serializes an optional JSON object returned by the API.
+// OSS returns "null" for empty objects, which is reported as "{}".
+func normalizedJSONValue(attribute, description string, value map[string]any) (jsontypes.Normalized, diag.Diagnostics) {
+	if value == nil {
+		return jsontypes.NewNormalizedValue("{}"), nil
+	}
+
+	byteSlice, err := json.Marshal(value)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), diag.Diagnostics{helpers.SerializeDataErrorDiagnostic(attribute, description, err)}
+	}
+
+	return jsontypes.NewNormalizedValue(string(byteSlice)), nil
+}
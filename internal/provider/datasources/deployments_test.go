@@ -0,0 +1,126 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type deploymentsFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+}
+
+func fixtureAccDeployments(cfg deploymentsFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_flow" "test" {
+	name = "test-deployments"
+	tags = ["test"]
+
+	{{.WorkspaceIDArg}}
+}
+
+resource "prefect_deployment" "nightly" {
+	name    = "nightly-etl"
+	flow_id = prefect_flow.test.id
+	tags    = ["etl", "nightly"]
+
+	schedules = [
+		{
+			slug = "nightly"
+			cron = "0 2 * * *"
+		},
+	]
+
+	{{.WorkspaceIDArg}}
+}
+
+resource "prefect_deployment" "adhoc" {
+	name    = "adhoc-report"
+	flow_id = prefect_flow.test.id
+	tags    = ["report"]
+	paused  = true
+
+	{{.WorkspaceIDArg}}
+}
+
+data "prefect_deployments" "by_flow" {
+	flow_name = prefect_flow.test.name
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_deployment.nightly, prefect_deployment.adhoc]
+}
+
+data "prefect_deployments" "by_tags" {
+	flow_name = prefect_flow.test.name
+	tags_all  = ["etl", "nightly"]
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_deployment.nightly, prefect_deployment.adhoc]
+}
+
+data "prefect_deployments" "paused" {
+	flow_name = prefect_flow.test.name
+	paused    = true
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_deployment.nightly, prefect_deployment.adhoc]
+}
+
+data "prefect_deployments" "by_name" {
+	name_like = "report"
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_deployment.nightly, prefect_deployment.adhoc]
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_deployments(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := deploymentsFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeployments(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// Deployments are sorted by name.
+					testutils.ExpectKnownValueListSize("data.prefect_deployments.by_flow", "deployments", 2),
+					testutils.ExpectKnownValue("data.prefect_deployments.by_flow", "deployments.0.name", "adhoc-report"),
+					testutils.ExpectKnownValue("data.prefect_deployments.by_flow", "deployments.1.name", "nightly-etl"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_deployments.by_tags", "deployments", 1),
+					testutils.ExpectKnownValue("data.prefect_deployments.by_tags", "deployments.0.name", "nightly-etl"),
+					testutils.ExpectKnownValueListSize("data.prefect_deployments.by_tags", "deployments.0.schedules", 1),
+					testutils.ExpectKnownValue("data.prefect_deployments.by_tags", "deployments.0.schedules.0.slug", "nightly"),
+					testutils.ExpectKnownValue("data.prefect_deployments.by_tags", "deployments.0.schedules.0.cron", "0 2 * * *"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_deployments.paused", "deployments", 1),
+					testutils.ExpectKnownValue("data.prefect_deployments.paused", "deployments.0.name", "adhoc-report"),
+					testutils.ExpectKnownValueBool("data.prefect_deployments.paused", "deployments.0.paused", true),
+
+					testutils.ExpectKnownValueListSize("data.prefect_deployments.by_name", "deployments", 1),
+					testutils.ExpectKnownValue("data.prefect_deployments.by_name", "deployments.0.name", "adhoc-report"),
+				},
+			},
+		},
+	})
+}
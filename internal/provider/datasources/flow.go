@@ -0,0 +1,310 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&FlowDataSource{})
+
+// FlowDataSource contains state for the data source.
+type FlowDataSource struct {
+	client api.PrefectClient
+}
+
+// FlowDataSourceModel defines the Terraform data source model.
+type FlowDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	FlowModel
+}
+
+// FlowModel defines a single flow, shared between the
+// flow (singular) and flows (plural) data sources.
+type FlowModel struct {
+	ID      customtypes.UUIDValue      `tfsdk:"id"`
+	Created customtypes.TimestampValue `tfsdk:"created"`
+	Updated customtypes.TimestampValue `tfsdk:"updated"`
+
+	Name        types.String          `tfsdk:"name"`
+	Tags        []types.String        `tfsdk:"tags"`
+	Deployments []FlowDeploymentModel `tfsdk:"deployments"`
+}
+
+// FlowDeploymentModel identifies a deployment of a flow.
+type FlowDeploymentModel struct {
+	ID   customtypes.UUIDValue `tfsdk:"id"`
+	Name types.String          `tfsdk:"name"`
+}
+
+// NewFlowDataSource returns a new FlowDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewFlowDataSource() datasource.DataSource {
+	return &FlowDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *FlowDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow"
+}
+
+// Shared set of schema attributes between flow (singular)
+// and flows (plural) datasources. Any flow (singular)
+// specific attributes will be added to a deep copy in the Schema method.
+var flowAttributesBase = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Flow ID (UUID)",
+	},
+	"created": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Timestamp of when the resource was created (RFC3339)",
+	},
+	"updated": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Timestamp of when the resource was updated (RFC3339)",
+	},
+	"name": schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the flow",
+	},
+	"tags": schema.ListAttribute{
+		Computed:    true,
+		Description: "Tags associated with the flow",
+		ElementType: types.StringType,
+	},
+	"deployments": schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "Deployments of the flow, sorted by name",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					CustomType:  customtypes.UUIDType{},
+					Description: "Deployment ID (UUID)",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "Name of the deployment",
+				},
+			},
+		},
+	},
+}
+
+// Schema defines the schema for the data source.
+func (d *FlowDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Create a copy of the base attributes and make
+	// the lookup attributes configurable, as they are
+	// not needed in the flows (plural) list
+	flowAttributes := make(map[string]schema.Attribute)
+	maps.Copy(flowAttributes, flowAttributesBase)
+	flowAttributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.UUIDType{},
+		Description: "Flow ID (UUID)",
+		Optional:    true,
+	}
+	flowAttributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the flow",
+		Optional:    true,
+	}
+	flowAttributes["account_id"] = schema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Description: "Account ID (UUID), defaults to the account set in the provider",
+		Optional:    true,
+	}
+	flowAttributes["workspace_id"] = schema.StringAttribute{
+		CustomType:  customtypes.UUIDType{},
+		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+		Optional:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about an existing Flow by ID or name.
+<br>
+Use this data source to reference Flows registered outside of Terraform, for example by CI using `+"`prefect deploy`"+`.
+The IDs and names of the Flow's Deployments are included.
+<br>
+For more information, see [write and run flows](https://docs.prefect.io/v3/develop/write-flows).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: flowAttributes,
+	}
+}
+
+// Configure adds the provider-configured client to the data source.
+func (d *FlowDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *FlowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model FlowDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Flows(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flow", err))
+
+		return
+	}
+
+	// A flow can be read by either ID or name
+	// If both are set, we prefer the ID
+	var flow *api.Flow
+
+	switch {
+	case !model.ID.IsNull():
+		flow, err = client.Get(ctx, model.ID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow", "get", err))
+
+			return
+		}
+	case !model.Name.IsNull():
+		// Flow names are unique within a workspace, so we'd expect
+		// only 1 Flow (or none) to be returned
+		var flows []*api.Flow
+		flows, err = client.List(ctx, api.FlowFilter{
+			Flows: &api.FlowFilterFlows{
+				Name: &api.FlowFilterName{Any: []string{model.Name.ValueString()}},
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flow", "list", err))
+
+			return
+		}
+
+		if len(flows) != 1 {
+			resp.Diagnostics.AddError(
+				"Could not find Flow",
+				fmt.Sprintf("Could not find Flow with name %s", model.Name.String()),
+			)
+
+			return
+		}
+
+		flow = flows[0]
+	default:
+		resp.Diagnostics.AddError(
+			"Both ID and Name are unset",
+			"Either a Flow ID or Name are required to read a Flow.",
+		)
+
+		return
+	}
+
+	deployments, err := flowDeploymentsByFlowID(ctx, d.client, model.AccountID, model.WorkspaceID, []*api.Flow{flow})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployments", "list", err))
+
+		return
+	}
+
+	model.FlowModel = flowModelFromAPI(flow, deployments[flow.ID])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// flowDeploymentsByFlowID fetches the deployments of the given flows
+// in a single request, and groups them by flow ID.
+func flowDeploymentsByFlowID(
+	ctx context.Context,
+	prefectClient api.PrefectClient,
+	accountID, workspaceID customtypes.UUIDValue,
+	flows []*api.Flow,
+) (map[uuid.UUID][]FlowDeploymentModel, error) {
+	byFlowID := make(map[uuid.UUID][]FlowDeploymentModel, len(flows))
+	if len(flows) == 0 {
+		return byFlowID, nil
+	}
+
+	client, err := prefectClient.Deployments(accountID.ValueUUID(), workspaceID.ValueUUID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deployments client: %w", err)
+	}
+
+	flowIDs := make([]uuid.UUID, 0, len(flows))
+	for _, flow := range flows {
+		flowIDs = append(flowIDs, flow.ID)
+	}
+
+	deployments, err := client.List(ctx, api.DeploymentFilter{
+		Flows: &api.DeploymentFilterFlows{
+			ID: &api.DeploymentFilterID{Any: flowIDs},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Deployments are returned sorted by name, so each
+	// flow's deployments end up sorted by name as well.
+	for _, deployment := range deployments {
+		byFlowID[deployment.FlowID] = append(byFlowID[deployment.FlowID], FlowDeploymentModel{
+			ID:   customtypes.NewUUIDValue(deployment.ID),
+			Name: types.StringValue(deployment.Name),
+		})
+	}
+
+	return byFlowID, nil
+}
+
+// flowModelFromAPI maps a flow returned by the API, along with its deployments, onto a FlowModel.
+func flowModelFromAPI(flow *api.Flow, deployments []FlowDeploymentModel) FlowModel {
+	model := FlowModel{
+		ID:          customtypes.NewUUIDValue(flow.ID),
+		Created:     customtypes.NewTimestampPointerValue(flow.Created),
+		Updated:     customtypes.NewTimestampPointerValue(flow.Updated),
+		Name:        types.StringValue(flow.Name),
+		Tags:        make([]types.String, 0, len(flow.Tags)),
+		Deployments: make([]FlowDeploymentModel, 0, len(deployments)),
+	}
+
+	for _, tag := range flow.Tags {
+		model.Tags = append(model.Tags, types.StringValue(tag))
+	}
+
+	model.Deployments = append(model.Deployments, deployments...)
+
+	return model
+}
@@ -0,0 +1,123 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type flowFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+}
+
+func fixtureAccFlow(cfg flowFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_flow" "etl" {
+	name = "etl-nightly"
+	tags = ["etl", "nightly"]
+
+	{{.WorkspaceIDArg}}
+}
+
+resource "prefect_flow" "report" {
+	name = "report-weekly"
+	tags = ["report"]
+
+	{{.WorkspaceIDArg}}
+}
+
+resource "prefect_deployment" "etl_b" {
+	name    = "b"
+	flow_id = prefect_flow.etl.id
+
+	{{.WorkspaceIDArg}}
+}
+
+resource "prefect_deployment" "etl_a" {
+	name    = "a"
+	flow_id = prefect_flow.etl.id
+
+	{{.WorkspaceIDArg}}
+}
+
+data "prefect_flow" "by_id" {
+	id = prefect_flow.etl.id
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_deployment.etl_a, prefect_deployment.etl_b]
+}
+
+data "prefect_flow" "by_name" {
+	name = prefect_flow.report.name
+
+	{{.WorkspaceIDArg}}
+}
+
+data "prefect_flows" "by_prefix" {
+	name_prefix = "etl-"
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_flow.etl, prefect_flow.report, prefect_deployment.etl_a, prefect_deployment.etl_b]
+}
+
+data "prefect_flows" "by_tags" {
+	tags = ["report"]
+
+	{{.WorkspaceIDArg}}
+
+	depends_on = [prefect_flow.etl, prefect_flow.report]
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_flow(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := flowFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccFlow(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue("data.prefect_flow.by_id", "name", "etl-nightly"),
+					testutils.ExpectKnownValueList("data.prefect_flow.by_id", "tags", []string{"etl", "nightly"}),
+					// Deployments are sorted by name.
+					testutils.ExpectKnownValueListSize("data.prefect_flow.by_id", "deployments", 2),
+					testutils.ExpectKnownValue("data.prefect_flow.by_id", "deployments.0.name", "a"),
+					testutils.ExpectKnownValue("data.prefect_flow.by_id", "deployments.1.name", "b"),
+
+					testutils.ExpectKnownValueNotNull("data.prefect_flow.by_name", "id"),
+					testutils.ExpectKnownValue("data.prefect_flow.by_name", "name", "report-weekly"),
+					testutils.ExpectKnownValueListSize("data.prefect_flow.by_name", "deployments", 0),
+				},
+			},
+			{
+				Config: fixtureAccFlow(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize("data.prefect_flows.by_prefix", "flows", 1),
+					testutils.ExpectKnownValue("data.prefect_flows.by_prefix", "flows.0.name", "etl-nightly"),
+					testutils.ExpectKnownValueListSize("data.prefect_flows.by_prefix", "flows.0.deployments", 2),
+
+					testutils.ExpectKnownValueListSize("data.prefect_flows.by_tags", "flows", 1),
+					testutils.ExpectKnownValue("data.prefect_flows.by_tags", "flows.0.name", "report-weekly"),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package datasources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&FlowsDataSource{})
+
+// FlowsDataSource contains state for the data source.
+type FlowsDataSource struct {
+	client api.PrefectClient
+}
+
+// FlowsDataSourceModel defines the Terraform data source model.
+type FlowsDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Set    `tfsdk:"tags"`
+
+	Flows []FlowModel `tfsdk:"flows"`
+}
+
+// NewFlowsDataSource returns a new FlowsDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewFlowsDataSource() datasource.DataSource {
+	return &FlowsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *FlowsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flows"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *FlowsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *FlowsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about multiple Flows.
+<br>
+Use this data source to search for Flows by tag or name prefix. Defaults to fetching all Flows in the Workspace.
+The IDs and names of each Flow's Deployments are included.
+<br>
+For more information, see [write and run flows](https://docs.prefect.io/v3/develop/write-flows).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return flows whose name starts with this value",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "Only return flows that have all of these tags",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"flows": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Flows returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: flowAttributesBase,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *FlowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model FlowsDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Flows(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Flows", err))
+
+		return
+	}
+
+	filter, diags := flowsFilterFromModel(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flows, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Flows", "list", err))
+
+		return
+	}
+
+	// The API only supports a partial name match, so narrow
+	// the results down to the flows that start with the prefix.
+	if !model.NamePrefix.IsNull() {
+		flows = filterFlowsByNamePrefix(flows, model.NamePrefix.ValueString())
+	}
+
+	deployments, err := flowDeploymentsByFlowID(ctx, d.client, model.AccountID, model.WorkspaceID, flows)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployments", "list", err))
+
+		return
+	}
+
+	model.Flows = make([]FlowModel, 0, len(flows))
+	for _, flow := range flows {
+		model.Flows = append(model.Flows, flowModelFromAPI(flow, deployments[flow.ID]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// flowsFilterFromModel builds the API filter from the configured filter attributes.
+// Filters that are not configured are left out of the request.
+func flowsFilterFromModel(ctx context.Context, model FlowsDataSourceModel) (api.FlowFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter api.FlowFilter
+
+	if model.NamePrefix.IsNull() && model.Tags.IsNull() {
+		return filter, diags
+	}
+
+	flows := api.FlowFilterFlows{}
+
+	if !model.NamePrefix.IsNull() {
+		flows.Name = &api.FlowFilterName{Like: model.NamePrefix.ValueStringPointer()}
+	}
+
+	if !model.Tags.IsNull() {
+		tags := api.FlowFilterTags{}
+		diags.Append(model.Tags.ElementsAs(ctx, &tags.All, false)...)
+		if diags.HasError() {
+			return filter, diags
+		}
+
+		flows.Tags = &tags
+	}
+
+	filter.Flows = &flows
+
+	return filter, diags
+}
+
+// filterFlowsByNamePrefix returns the flows whose name starts with the given prefix.
+func filterFlowsByNamePrefix(flows []*api.Flow, prefix string) []*api.Flow {
+	filtered := make([]*api.Flow, 0, len(flows))
+	for _, flow := range flows {
+		if strings.HasPrefix(flow.Name, prefix) {
+			filtered = append(filtered, flow)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,246 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&VariablesDataSource{})
+
+// VariablesDataSource contains state for the data source.
+type VariablesDataSource struct {
+	client api.PrefectClient
+}
+
+// VariablesDataSourceModel defines the Terraform data source model.
+type VariablesDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	NameLike  types.String `tfsdk:"name_like"`
+	ValueLike types.String `tfsdk:"value_like"`
+	Tags      types.Set    `tfsdk:"tags"`
+
+	Variables []VariablesVariableModel `tfsdk:"variables"`
+}
+
+// VariablesVariableModel defines a single variable returned by the data source.
+type VariablesVariableModel struct {
+	BaseModel
+
+	Name  types.String         `tfsdk:"name"`
+	Value jsontypes.Normalized `tfsdk:"value"`
+	Tags  []types.String       `tfsdk:"tags"`
+}
+
+// NewVariablesDataSource returns a new VariablesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariablesDataSource() datasource.DataSource {
+	return &VariablesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *VariablesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *VariablesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *VariablesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about multiple Variables.
+<br>
+Use this data source to search for Variables by name, value or tags.
+All filters are optional and combined with AND. Defaults to fetching all Variables in the Workspace.
+<br>
+For more information, see [get and set variables](https://docs.prefect.io/v3/develop/variables).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"name_like": schema.StringAttribute{
+				Description: "Only return variables whose name contains this value (case-insensitive)",
+				Optional:    true,
+			},
+			"value_like": schema.StringAttribute{
+				Description: "Only return variables whose value contains this value (case-insensitive)",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Only return variables that have all of these tags",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"variables": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Variables returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Variable ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the variable",
+						},
+						"value": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "Value of the variable, as a JSON string. Use `jsondecode()` to access the value.",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							Description: "Tags associated with the variable",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *VariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model VariablesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Variables(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variables", err))
+
+		return
+	}
+
+	filter, diags := variablesFilterFromModel(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variables, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variables", "list", err))
+
+		return
+	}
+
+	model.Variables = make([]VariablesVariableModel, 0, len(variables))
+	for i := range variables {
+		variable := variables[i]
+
+		byteSlice, err := json.Marshal(variable.Value)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("value", "Variable Value", err))
+
+			return
+		}
+
+		tags := make([]types.String, 0, len(variable.Tags))
+		for _, tag := range variable.Tags {
+			tags = append(tags, types.StringValue(tag))
+		}
+
+		model.Variables = append(model.Variables, VariablesVariableModel{
+			BaseModel: BaseModel{
+				ID:      customtypes.NewUUIDValue(variable.ID),
+				Created: customtypes.NewTimestampPointerValue(variable.Created),
+				Updated: customtypes.NewTimestampPointerValue(variable.Updated),
+			},
+			Name:  types.StringValue(variable.Name),
+			Value: jsontypes.NewNormalizedValue(string(byteSlice)),
+			Tags:  tags,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// variablesFilterFromModel builds the API filter from the configured filter attributes.
+// Filters that are not configured are left out of the request.
+func variablesFilterFromModel(ctx context.Context, model VariablesDataSourceModel) (api.VariableFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filter api.VariableFilter
+
+	if !model.NameLike.IsNull() {
+		filter.Name = &api.VariableFilterName{Like: model.NameLike.ValueStringPointer()}
+	}
+
+	if !model.ValueLike.IsNull() {
+		filter.Value = &api.VariableFilterValue{Like: model.ValueLike.ValueStringPointer()}
+	}
+
+	if !model.Tags.IsNull() {
+		tags := api.VariableFilterTags{}
+		diags.Append(model.Tags.ElementsAs(ctx, &tags.All, false)...)
+		if diags.HasError() {
+			return filter, diags
+		}
+
+		filter.Tags = &tags
+	}
+
+	return filter, diags
+}
@@ -0,0 +1,112 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type variablesFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+}
+
+func fixtureAccVariables(cfg variablesFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_variable" "flag_b" {
+	name  = "flag_b"
+	value = false
+	tags  = ["feature-flag"]
+
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_variable" "flag_a" {
+	name  = "flag_a"
+	value = true
+	tags  = ["feature-flag", "beta"]
+
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_variable" "setting" {
+	name  = "timeout_setting"
+	value = "30s"
+
+	{{ .WorkspaceIDArg }}
+}
+
+data "prefect_variables" "all" {
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_variable.flag_a, prefect_variable.flag_b, prefect_variable.setting]
+}
+
+data "prefect_variables" "feature_flags" {
+	tags = ["feature-flag"]
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_variable.flag_a, prefect_variable.flag_b, prefect_variable.setting]
+}
+
+data "prefect_variables" "beta" {
+	tags = ["feature-flag", "beta"]
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_variable.flag_a, prefect_variable.flag_b, prefect_variable.setting]
+}
+
+data "prefect_variables" "by_name" {
+	name_like = "setting"
+
+	{{ .WorkspaceIDArg }}
+
+	depends_on = [prefect_variable.flag_a, prefect_variable.flag_b, prefect_variable.setting]
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_variables(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := variablesFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccVariables(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize("data.prefect_variables.all", "variables", 3),
+
+					// Variables are sorted by name.
+					testutils.ExpectKnownValueListSize("data.prefect_variables.feature_flags", "variables", 2),
+					testutils.ExpectKnownValue("data.prefect_variables.feature_flags", "variables.0.name", "flag_a"),
+					testutils.ExpectKnownValue("data.prefect_variables.feature_flags", "variables.0.value", "true"),
+					testutils.ExpectKnownValue("data.prefect_variables.feature_flags", "variables.1.name", "flag_b"),
+					testutils.ExpectKnownValue("data.prefect_variables.feature_flags", "variables.1.value", "false"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_variables.beta", "variables", 1),
+					testutils.ExpectKnownValue("data.prefect_variables.beta", "variables.0.name", "flag_a"),
+
+					testutils.ExpectKnownValueListSize("data.prefect_variables.by_name", "variables", 1),
+					testutils.ExpectKnownValue("data.prefect_variables.by_name", "variables.0.name", "timeout_setting"),
+					testutils.ExpectKnownValue("data.prefect_variables.by_name", "variables.0.value", "\"30s\""),
+				},
+			},
+		},
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -38,6 +40,10 @@ type WorkPoolDataSourceModel struct {
 	ConcurrencyLimit types.Int64           `tfsdk:"concurrency_limit"`
 	DefaultQueueID   customtypes.UUIDValue `tfsdk:"default_queue_id"`
 	BaseJobTemplate  types.String          `tfsdk:"base_job_template"`
+	Status           types.String          `tfsdk:"status"`
+
+	ActiveWorkerCount types.Int64 `tfsdk:"active_worker_count"`
+	ActiveWorkerNames types.List  `tfsdk:"active_worker_names"`
 }
 
 // NewWorkPoolDataSource returns a new WorkPoolDataSource.
@@ -121,6 +127,10 @@ var workPoolAttributesBase = map[string]schema.Attribute{
 		Computed:    true,
 		Description: "The base job template for the work pool, as a JSON string",
 	},
+	"status": schema.StringAttribute{
+		Computed:    true,
+		Description: "Status of the work pool: `READY` when at least one worker is polling it, `NOT_READY` otherwise, or `PAUSED`",
+	},
 }
 
 // Schema defines the schema for the data source.
@@ -140,6 +150,15 @@ func (d *WorkPoolDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 		Optional:    true,
 	}
+	workPoolAttributes["active_worker_count"] = schema.Int64Attribute{
+		Computed:    true,
+		Description: "Number of workers polling the work pool, i.e. with the `ONLINE` status",
+	}
+	workPoolAttributes["active_worker_names"] = schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "Names of the workers polling the work pool, sorted by name",
+	}
 
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(`
@@ -188,6 +207,7 @@ func (d *WorkPoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 	model.Paused = types.BoolValue(pool.IsPaused)
 	model.ConcurrencyLimit = types.Int64PointerValue(pool.ConcurrencyLimit)
 	model.DefaultQueueID = customtypes.NewUUIDValue(pool.DefaultQueueID)
+	model.Status = types.StringPointerValue(pool.Status)
 
 	if pool.BaseJobTemplate != nil {
 		var builder strings.Builder
@@ -206,6 +226,35 @@ func (d *WorkPoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 		model.BaseJobTemplate = types.StringValue(builder.String())
 	}
 
+	workersClient, err := d.client.Workers(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), pool.Name)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workers", err))
+
+		return
+	}
+
+	workers, err := workersClient.List(ctx, api.WorkerFilter{Status: []string{workerStatusOnline}})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workers", "list", err))
+
+		return
+	}
+
+	activeWorkerNames := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		activeWorkerNames = append(activeWorkerNames, worker.Name)
+	}
+	slices.Sort(activeWorkerNames)
+
+	model.ActiveWorkerCount = types.Int64Value(int64(len(activeWorkerNames)))
+
+	var diags diag.Diagnostics
+	model.ActiveWorkerNames, diags = types.ListValueFrom(ctx, types.StringType, activeWorkerNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
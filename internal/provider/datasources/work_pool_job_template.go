@@ -0,0 +1,492 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&WorkPoolJobTemplateDataSource{})
+
+// WorkPoolJobTemplateDataSource contains state for the data source.
+type WorkPoolJobTemplateDataSource struct {
+	client api.PrefectClient
+}
+
+// WorkPoolJobTemplateDataSourceModel defines the Terraform data source model.
+type WorkPoolJobTemplateDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	WorkerType                types.String         `tfsdk:"worker_type"`
+	Source                    types.String         `tfsdk:"source"`
+	VariableDefaults          types.Map            `tfsdk:"variable_defaults"`
+	RequiredVariables         types.Set            `tfsdk:"required_variables"`
+	HiddenVariables           types.Set            `tfsdk:"hidden_variables"`
+	JobConfigurationMerge     jsontypes.Normalized `tfsdk:"job_configuration_merge_patch"`
+	JobConfigurationJSONPatch jsontypes.Normalized `tfsdk:"job_configuration_json_patch"`
+
+	BaseJobTemplate          jsontypes.Normalized `tfsdk:"base_job_template"`
+	CanonicalBaseJobTemplate types.String         `tfsdk:"canonical_base_job_template"`
+}
+
+// workPoolJobTemplateOverrides holds the overrides applied on top of
+// a worker type's default base job template.
+type workPoolJobTemplateOverrides struct {
+	variableDefaults  map[string]any
+	requiredVariables []string
+	hiddenVariables   []string
+	mergePatch        any
+	jsonPatch         []helpers.JSONPatchOperation
+}
+
+// templatePlaceholderRegex matches `{{ variable }}` placeholders in a job configuration.
+// Placeholders referencing other objects, such as `{{ prefect.variables.name }}`, are not matched.
+var templatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// NewWorkPoolJobTemplateDataSource returns a new WorkPoolJobTemplateDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkPoolJobTemplateDataSource() datasource.DataSource {
+	return &WorkPoolJobTemplateDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkPoolJobTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_pool_job_template"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *WorkPoolJobTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkPoolJobTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Build a base job template for a Work Pool, starting from the default base job template of a Worker type.
+<br>
+Use this data source instead of hand-editing the JSON from `+"`prefect_worker_metadata`"+`.
+Variable defaults and required variables are applied to the variables schema first.
+The job configuration is then patched, with the JSON merge patch applied before the JSON patch.
+Finally, hidden variables are removed from the variables schema, and their defaults are inlined into the job configuration.
+<br>
+The resulting template is validated, so that every variable referenced by the job configuration is defined.
+<br>
+For more information, see [overriding job variables](https://docs.prefect.io/v3/deploy/infrastructure-concepts/customize).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"worker_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Worker type to start from, for example `kubernetes`, `ecs`, `docker`, `process` or `cloud-run-v2:push`",
+			},
+			"source": workerMetadataSourceAttribute(),
+			"variable_defaults": schema.MapAttribute{
+				Optional:    true,
+				ElementType: jsontypes.NormalizedType{},
+				Description: "Map of variable names to their new default value, as a JSON string. Use `jsonencode()` to set the values.",
+			},
+			"required_variables": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Names of variables that must be set by each deployment",
+			},
+			"hidden_variables": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Names of variables to hide from deployments. Their default value is inlined into the job configuration, so each of them must have a default.",
+			},
+			"job_configuration_merge_patch": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON merge patch (RFC 7396) to apply to the job configuration. Use `null` values to remove keys.",
+			},
+			"job_configuration_json_patch": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "JSON patch (RFC 6902) to apply to the job configuration, as a JSON array of operations. Paths are relative to the job configuration.",
+			},
+			"base_job_template": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The resulting base job template, as a JSON string",
+			},
+			"canonical_base_job_template": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resulting base job template, as an indented JSON string with sorted keys. Use this value for `prefect_work_pool.base_job_template` to get line-by-line plan diffs.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkPoolJobTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model WorkPoolJobTemplateDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overrides workPoolJobTemplateOverrides
+
+	if !model.VariableDefaults.IsNull() {
+		var defaults map[string]jsontypes.Normalized
+		resp.Diagnostics.Append(model.VariableDefaults.ElementsAs(ctx, &defaults, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		overrides.variableDefaults = make(map[string]any, len(defaults))
+		for name, value := range defaults {
+			var decoded any
+			resp.Diagnostics.Append(value.Unmarshal(&decoded)...)
+			overrides.variableDefaults[name] = decoded
+		}
+	}
+
+	resp.Diagnostics.Append(model.RequiredVariables.ElementsAs(ctx, &overrides.requiredVariables, false)...)
+	resp.Diagnostics.Append(model.HiddenVariables.ElementsAs(ctx, &overrides.hiddenVariables, false)...)
+
+	if !model.JobConfigurationMerge.IsNull() {
+		resp.Diagnostics.Append(model.JobConfigurationMerge.Unmarshal(&overrides.mergePatch)...)
+	}
+
+	if !model.JobConfigurationJSONPatch.IsNull() {
+		resp.Diagnostics.Append(model.JobConfigurationJSONPatch.Unmarshal(&overrides.jsonPatch)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workerTypeByPackage, diags := getWorkerMetadata(ctx, d.client, model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.Source.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workerType := model.WorkerType.ValueString()
+
+	// Flatten the response payload so that the result is
+	// a map of worker types to their default base job configurations.
+	defaultTemplates := make(map[string]json.RawMessage)
+	for _, metadataByWorkerType := range workerTypeByPackage {
+		for metadataWorkerType, metadata := range metadataByWorkerType {
+			defaultTemplates[metadataWorkerType] = metadata.DefaultBaseJobConfiguration
+		}
+	}
+
+	defaultTemplate, ok := defaultTemplates[workerType]
+	if !ok {
+		workerTypes := make([]string, 0, len(defaultTemplates))
+		for name := range defaultTemplates {
+			workerTypes = append(workerTypes, name)
+		}
+		sort.Strings(workerTypes)
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("worker_type"),
+			"Unknown worker type",
+			fmt.Sprintf("Could not find worker type %q. Available worker types: %s", workerType, strings.Join(workerTypes, ", ")),
+		)
+
+		return
+	}
+
+	template, err := buildWorkPoolJobTemplate(defaultTemplate, overrides)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid base job template",
+			fmt.Sprintf("Could not build the base job template for worker type %q: %s", workerType, err.Error()),
+		)
+
+		return
+	}
+
+	byteSlice, err := json.Marshal(template)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("base_job_template", "Base Job Template", err))
+
+		return
+	}
+	model.BaseJobTemplate = jsontypes.NewNormalizedValue(string(byteSlice))
+
+	// Maps are marshaled with sorted keys, so indenting the
+	// template is enough to get a stable, diff-friendly form.
+	canonicalByteSlice, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("canonical_base_job_template", "Base Job Template", err))
+
+		return
+	}
+	model.CanonicalBaseJobTemplate = types.StringValue(string(canonicalByteSlice))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// buildWorkPoolJobTemplate applies the overrides to a default base job template,
+// and validates the result.
+func buildWorkPoolJobTemplate(defaultTemplate json.RawMessage, overrides workPoolJobTemplateOverrides) (map[string]any, error) {
+	var template map[string]any
+	if err := json.Unmarshal(defaultTemplate, &template); err != nil {
+		return nil, fmt.Errorf("failed to parse default base job template: %w", err)
+	}
+
+	jobConfiguration, _ := template["job_configuration"].(map[string]any)
+	if jobConfiguration == nil {
+		jobConfiguration = map[string]any{}
+	}
+
+	variables, _ := template["variables"].(map[string]any)
+	if variables == nil {
+		variables = map[string]any{"type": "object"}
+	}
+
+	properties, _ := variables["properties"].(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
+	required := map[string]bool{}
+	if requiredList, ok := variables["required"].([]any); ok {
+		for _, name := range requiredList {
+			if name, ok := name.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+
+	propertyFor := func(name, override string) (map[string]any, error) {
+		property, ok := properties[name].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: variable %q is not defined", override, name)
+		}
+
+		return property, nil
+	}
+
+	for name, value := range overrides.variableDefaults {
+		property, err := propertyFor(name, "variable_defaults")
+		if err != nil {
+			return nil, err
+		}
+
+		property["default"] = value
+	}
+
+	for _, name := range overrides.requiredVariables {
+		if _, err := propertyFor(name, "required_variables"); err != nil {
+			return nil, err
+		}
+
+		required[name] = true
+	}
+
+	var patched any = jobConfiguration
+	if overrides.mergePatch != nil {
+		patched = helpers.ApplyJSONMergePatch(patched, overrides.mergePatch)
+	}
+
+	if len(overrides.jsonPatch) > 0 {
+		var err error
+		patched, err = helpers.ApplyJSONPatch(patched, overrides.jsonPatch)
+		if err != nil {
+			return nil, fmt.Errorf("job_configuration_json_patch: %w", err)
+		}
+	}
+
+	jobConfiguration, ok := patched.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("job configuration must be a JSON object after applying patches")
+	}
+
+	hidden := make(map[string]any, len(overrides.hiddenVariables))
+	for _, name := range overrides.hiddenVariables {
+		property, err := propertyFor(name, "hidden_variables")
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := property["default"]
+		if !ok {
+			return nil, fmt.Errorf("hidden_variables: variable %q has no default value to inline", name)
+		}
+
+		hidden[name] = value
+		delete(properties, name)
+		delete(required, name)
+	}
+
+	inlined, err := inlineTemplateVariables(jobConfiguration, hidden)
+	if err != nil {
+		return nil, err
+	}
+
+	var unknown []string
+	for name := range templatePlaceholders(inlined) {
+		if _, ok := properties[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return nil, fmt.Errorf("job configuration references undefined variables: %s", strings.Join(unknown, ", "))
+	}
+
+	requiredList := make([]string, 0, len(required))
+	for name := range required {
+		requiredList = append(requiredList, name)
+	}
+	sort.Strings(requiredList)
+
+	variables["properties"] = properties
+	if len(requiredList) > 0 {
+		variables["required"] = requiredList
+	} else {
+		delete(variables, "required")
+	}
+
+	template["job_configuration"] = inlined
+	template["variables"] = variables
+
+	return template, nil
+}
+
+// inlineTemplateVariables replaces the placeholders of the given variables with their values.
+// A string made of a single placeholder is replaced by the value itself, keeping its JSON type,
+// while placeholders embedded in a longer string are replaced by the value's text.
+func inlineTemplateVariables(node any, values map[string]any) (any, error) {
+	if len(values) == 0 {
+		return node, nil
+	}
+
+	switch value := node.(type) {
+	case map[string]any:
+		for key, child := range value {
+			inlined, err := inlineTemplateVariables(child, values)
+			if err != nil {
+				return nil, err
+			}
+
+			value[key] = inlined
+		}
+
+		return value, nil
+
+	case []any:
+		for i, child := range value {
+			inlined, err := inlineTemplateVariables(child, values)
+			if err != nil {
+				return nil, err
+			}
+
+			value[i] = inlined
+		}
+
+		return value, nil
+
+	case string:
+		if match := templatePlaceholderRegex.FindStringSubmatch(value); match != nil && match[0] == value {
+			if replacement, ok := values[match[1]]; ok {
+				return replacement, nil
+			}
+		}
+
+		var inlineErr error
+		result := templatePlaceholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+			name := templatePlaceholderRegex.FindStringSubmatch(placeholder)[1]
+
+			replacement, ok := values[name]
+			if !ok {
+				return placeholder
+			}
+
+			switch replacement := replacement.(type) {
+			case string:
+				return replacement
+			case float64, bool:
+				return fmt.Sprint(replacement)
+			default:
+				inlineErr = fmt.Errorf("hidden_variables: variable %q is used inside a string, so its default must be a string, number or boolean", name)
+
+				return placeholder
+			}
+		})
+
+		return result, inlineErr
+
+	default:
+		return node, nil
+	}
+}
+
+// templatePlaceholders returns the set of variable names referenced by placeholders in the node.
+func templatePlaceholders(node any) map[string]bool {
+	names := map[string]bool{}
+
+	var walk func(node any)
+	walk = func(node any) {
+		switch value := node.(type) {
+		case map[string]any:
+			for _, child := range value {
+				walk(child)
+			}
+		case []any:
+			for _, child := range value {
+				walk(child)
+			}
+		case string:
+			for _, match := range templatePlaceholderRegex.FindAllStringSubmatch(value, -1) {
+				names[match[1]] = true
+			}
+		}
+	}
+
+	walk(node)
+
+	return names
+}
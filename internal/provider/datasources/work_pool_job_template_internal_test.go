@@ -0,0 +1,189 @@
+package datasources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefaultBaseJobTemplate = `{
+	"job_configuration": {
+		"command": "{{ command }}",
+		"env": "{{ env }}",
+		"image": "{{ image }}",
+		"labels": "{{ labels }}",
+		"name": "{{ name }}",
+		"stream_output": "{{ stream_output }}"
+	},
+	"variables": {
+		"type": "object",
+		"properties": {
+			"command": {"type": "string"},
+			"env": {"type": "object", "default": {}},
+			"image": {"type": "string", "default": "prefecthq/prefect:3-latest"},
+			"labels": {"type": "object"},
+			"name": {"type": "string"},
+			"stream_output": {"type": "boolean", "default": true}
+		},
+		"required": ["image"]
+	}
+}`
+
+func TestBuildWorkPoolJobTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		overrides workPoolJobTemplateOverrides
+		expected  string
+	}{
+		{
+			name:     "no overrides",
+			expected: testDefaultBaseJobTemplate,
+		},
+		{
+			name: "defaults, required, patches and hidden variables",
+			overrides: workPoolJobTemplateOverrides{
+				variableDefaults:  map[string]any{"image": "my-registry/flows:1.0"},
+				requiredVariables: []string{"name", "command"},
+				hiddenVariables:   []string{"stream_output", "image"},
+				mergePatch: map[string]any{
+					"labels": nil,
+					"name":   "flow-{{ name }}",
+				},
+				jsonPatch: []helpers.JSONPatchOperation{
+					{Op: "add", Path: "/image_pull_policy", Value: json.RawMessage(`"Always"`)},
+				},
+			},
+			expected: `{
+				"job_configuration": {
+					"command": "{{ command }}",
+					"env": "{{ env }}",
+					"image": "my-registry/flows:1.0",
+					"image_pull_policy": "Always",
+					"name": "flow-{{ name }}",
+					"stream_output": true
+				},
+				"variables": {
+					"type": "object",
+					"properties": {
+						"command": {"type": "string"},
+						"env": {"type": "object", "default": {}},
+						"labels": {"type": "object"},
+						"name": {"type": "string"}
+					},
+					"required": ["command", "name"]
+				}
+			}`,
+		},
+		{
+			name: "hidden variable inlined in a string",
+			overrides: workPoolJobTemplateOverrides{
+				hiddenVariables: []string{"stream_output"},
+				mergePatch:      map[string]any{"command": "run --stream={{ stream_output }}"},
+			},
+			expected: `{
+				"job_configuration": {
+					"command": "run --stream=true",
+					"env": "{{ env }}",
+					"image": "{{ image }}",
+					"labels": "{{ labels }}",
+					"name": "{{ name }}",
+					"stream_output": true
+				},
+				"variables": {
+					"type": "object",
+					"properties": {
+						"command": {"type": "string"},
+						"env": {"type": "object", "default": {}},
+						"image": {"type": "string", "default": "prefecthq/prefect:3-latest"},
+						"labels": {"type": "object"},
+						"name": {"type": "string"}
+					},
+					"required": ["image"]
+				}
+			}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			template, err := buildWorkPoolJobTemplate(json.RawMessage(testDefaultBaseJobTemplate), test.overrides)
+			require.NoError(t, err)
+
+			actual, err := json.Marshal(template)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, test.expected, string(actual))
+		})
+	}
+}
+
+func TestBuildWorkPoolJobTemplate_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		overrides workPoolJobTemplateOverrides
+		expected  string
+	}{
+		{
+			name:      "default for an undefined variable",
+			overrides: workPoolJobTemplateOverrides{variableDefaults: map[string]any{"cpu": 1}},
+			expected:  `variable_defaults: variable "cpu" is not defined`,
+		},
+		{
+			name:      "undefined required variable",
+			overrides: workPoolJobTemplateOverrides{requiredVariables: []string{"cpu"}},
+			expected:  `required_variables: variable "cpu" is not defined`,
+		},
+		{
+			name:      "hidden variable without a default",
+			overrides: workPoolJobTemplateOverrides{hiddenVariables: []string{"name"}},
+			expected:  `hidden_variables: variable "name" has no default value to inline`,
+		},
+		{
+			name: "non-scalar hidden variable inside a string",
+			overrides: workPoolJobTemplateOverrides{
+				hiddenVariables: []string{"env"},
+				mergePatch:      map[string]any{"command": "run {{ env }}"},
+			},
+			expected: `hidden_variables: variable "env" is used inside a string, so its default must be a string, number or boolean`,
+		},
+		{
+			name: "invalid JSON patch",
+			overrides: workPoolJobTemplateOverrides{
+				jsonPatch: []helpers.JSONPatchOperation{{Op: "remove", Path: "/cpu"}},
+			},
+			expected: `job_configuration_json_patch: operation 0 (remove /cpu): path not found: member "cpu" does not exist`,
+		},
+		{
+			name: "job configuration replaced by a non-object",
+			overrides: workPoolJobTemplateOverrides{
+				jsonPatch: []helpers.JSONPatchOperation{{Op: "replace", Path: "", Value: json.RawMessage(`[]`)}},
+			},
+			expected: "job configuration must be a JSON object after applying patches",
+		},
+		{
+			name: "placeholder for an undefined variable",
+			overrides: workPoolJobTemplateOverrides{
+				mergePatch: map[string]any{"cpu": "{{ cpu }}", "memory": "{{ memory }}", "token": "{{ prefect.variables.token }}"},
+			},
+			expected: "job configuration references undefined variables: cpu, memory",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := buildWorkPoolJobTemplate(json.RawMessage(testDefaultBaseJobTemplate), test.overrides)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package datasources_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type workPoolJobTemplateFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+	WorkPoolName   string
+}
+
+func fixtureAccWorkPoolJobTemplate(cfg workPoolJobTemplateFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+data "prefect_work_pool_job_template" "process" {
+	worker_type = "process"
+
+	variable_defaults = {
+		working_dir = jsonencode("/opt/flows")
+	}
+	required_variables = ["command"]
+	hidden_variables   = ["stream_output"]
+
+	job_configuration_merge_patch = jsonencode({
+		labels = null
+	})
+	job_configuration_json_patch = jsonencode([
+		{ op = "add", path = "/labels", value = { team = "data" } },
+	])
+
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_work_pool" "process" {
+	name              = "{{ .WorkPoolName }}"
+	type              = "process"
+	base_job_template = data.prefect_work_pool_job_template.process.canonical_base_job_template
+
+	{{ .WorkspaceIDArg }}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+func fixtureAccWorkPoolJobTemplateUnknownVariable(cfg workPoolJobTemplateFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+data "prefect_work_pool_job_template" "process" {
+	worker_type = "process"
+
+	job_configuration_merge_patch = jsonencode({
+		cpu = "{{"{{"}} cpu {{"}}"}}"
+	})
+
+	{{ .WorkspaceIDArg }}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_work_pool_job_template(t *testing.T) {
+	datasourceName := "data.prefect_work_pool_job_template.process"
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := workPoolJobTemplateFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+		WorkPoolName:   testutils.NewRandomPrefixedString(),
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccWorkPoolJobTemplate(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNotNull(datasourceName, "base_job_template"),
+					testutils.ExpectKnownValueNotNull(datasourceName, "canonical_base_job_template"),
+					testutils.CompareValuePairs(datasourceName, "canonical_base_job_template", "prefect_work_pool.process", "base_job_template"),
+				},
+			},
+			{
+				// Re-applying the same configuration must not produce a diff on the work pool.
+				Config:   fixtureAccWorkPoolJobTemplate(cfg),
+				PlanOnly: true,
+			},
+			{
+				Config:      fixtureAccWorkPoolJobTemplateUnknownVariable(cfg),
+				ExpectError: regexp.MustCompile("job configuration references undefined variables: cpu"),
+			},
+		},
+	})
+}
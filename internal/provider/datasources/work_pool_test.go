@@ -72,6 +72,10 @@ func TestAccDatasource_work_pool(t *testing.T) {
 					testutils.ExpectKnownValueNotNull(singleWorkPoolDatasourceName, "paused"),
 					testutils.ExpectKnownValueNotNull(singleWorkPoolDatasourceName, "default_queue_id"),
 					testutils.ExpectKnownValueNotNull(singleWorkPoolDatasourceName, "base_job_template"),
+					// No worker polls the new work pool.
+					testutils.ExpectKnownValue(singleWorkPoolDatasourceName, "status", "NOT_READY"),
+					testutils.ExpectKnownValueNumber(singleWorkPoolDatasourceName, "active_worker_count", 0),
+					testutils.ExpectKnownValueListSize(singleWorkPoolDatasourceName, "active_worker_names", 0),
 				},
 			},
 			{
@@ -86,6 +90,7 @@ func TestAccDatasource_work_pool(t *testing.T) {
 					testutils.ExpectKnownValueNotNull(multipleWorkPoolDatasourceName, "work_pools.0.paused"),
 					testutils.ExpectKnownValueNotNull(multipleWorkPoolDatasourceName, "work_pools.0.default_queue_id"),
 					testutils.ExpectKnownValueNotNull(multipleWorkPoolDatasourceName, "work_pools.0.base_job_template"),
+					testutils.ExpectKnownValue(multipleWorkPoolDatasourceName, "work_pools.0.status", "NOT_READY"),
 				},
 			},
 		},
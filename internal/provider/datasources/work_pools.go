@@ -153,6 +153,7 @@ func (d *WorkPoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		"concurrency_limit": types.Int64Type,
 		"default_queue_id":  customtypes.UUIDType{},
 		"base_job_template": types.StringType,
+		"status":            types.StringType,
 	}
 
 	poolObjects := make([]attr.Value, 0, len(pools))
@@ -167,6 +168,7 @@ func (d *WorkPoolsDataSource) Read(ctx context.Context, req datasource.ReadReque
 			"paused":            types.BoolValue(pool.IsPaused),
 			"concurrency_limit": types.Int64PointerValue(pool.ConcurrencyLimit),
 			"default_queue_id":  customtypes.NewUUIDValue(pool.DefaultQueueID),
+			"status":            types.StringPointerValue(pool.Status),
 		}
 
 		if pool.BaseJobTemplate == nil {
@@ -31,6 +31,9 @@ type WorkQueueDataSourceModel struct {
 	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
 	Priority         types.Int64  `tfsdk:"priority"`
 	WorkPoolName     types.String `tfsdk:"work_pool_name"`
+
+	Status     types.String               `tfsdk:"status"`
+	LastPolled customtypes.TimestampValue `tfsdk:"last_polled"`
 }
 
 // NewWorkQueueDataSource returns a new WorkQueueDataSource.
@@ -108,6 +111,15 @@ var workQueueAttributesBase = map[string]schema.Attribute{
 		Description: "The concurrency limit applied to this work queue",
 		Optional:    true,
 	},
+	"status": schema.StringAttribute{
+		Computed:    true,
+		Description: "Status of the work queue: `READY` when it was polled recently, `NOT_READY` otherwise, or `PAUSED`",
+	},
+	"last_polled": schema.StringAttribute{
+		Computed:    true,
+		CustomType:  customtypes.TimestampType{},
+		Description: "Date and time that the work queue was last polled by a worker in RFC 3339 format",
+	},
 }
 
 // Schema defines the schema for the data source.
@@ -177,6 +189,8 @@ func (d *WorkQueueDataSource) Read(ctx context.Context, req datasource.ReadReque
 	model.ConcurrencyLimit = types.Int64PointerValue(queue.ConcurrencyLimit)
 	model.Priority = types.Int64PointerValue(queue.Priority)
 	model.WorkPoolName = types.StringValue(queue.WorkPoolName)
+	model.Status = types.StringPointerValue(queue.Status)
+	model.LastPolled = customtypes.NewTimestampPointerValue(queue.LastPolled)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
@@ -112,6 +112,9 @@ func TestAccDatasource_work_queue(t *testing.T) {
 					resource.TestCheckResourceAttr(singleWorkQueueDatasourceName, "is_paused", "false"),
 					resource.TestCheckResourceAttr(singleWorkQueueDatasourceName, "priority", "1"),
 					resource.TestCheckResourceAttr(singleWorkQueueDatasourceName, "description", "my work queue"),
+					// No worker polls the new work queue.
+					resource.TestCheckResourceAttr(singleWorkQueueDatasourceName, "status", "NOT_READY"),
+					resource.TestCheckNoResourceAttr(singleWorkQueueDatasourceName, "last_polled"),
 				),
 			},
 			{
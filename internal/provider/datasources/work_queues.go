@@ -138,6 +138,8 @@ func (d *WorkQueuesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		"concurrency_limit": types.Int64Type,
 		"priority":          types.Int64Type,
 		"work_pool_name":    types.StringType,
+		"status":            types.StringType,
+		"last_polled":       customtypes.TimestampType{},
 	}
 
 	// Map each work queue to its attributes
@@ -153,6 +155,8 @@ func (d *WorkQueuesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			"concurrency_limit": types.Int64PointerValue(queue.ConcurrencyLimit),
 			"priority":          types.Int64PointerValue(queue.Priority),
 			"work_pool_name":    types.StringValue(queue.WorkPoolName),
+			"status":            types.StringPointerValue(queue.Status),
+			"last_polled":       customtypes.NewTimestampPointerValue(queue.LastPolled),
 		}
 
 		// Convert the attributes to match the expected type
@@ -3,15 +3,28 @@ package datasources
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+	"github.com/prefecthq/terraform-provider-prefect/internal/workermetadata"
+)
+
+// Sources that worker metadata can be read from.
+const (
+	workerMetadataSourceServer   = "server"
+	workerMetadataSourceEmbedded = "embedded"
+	workerMetadataSourceAuto     = "auto"
 )
 
 type WorkerMetadataDataSource struct {
@@ -21,6 +34,7 @@ type WorkerMetadataDataSource struct {
 type WorkerMetadataDataSourceModel struct {
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+	Source      types.String          `tfsdk:"source"`
 
 	BaseJobConfigs types.Object `tfsdk:"base_job_configs"`
 }
@@ -61,6 +75,10 @@ Get metadata information about the common Worker types, such as Kubernetes, ECS,
 <br>
 Use this data source to get the default base job configurations for those common Worker types.
 <br>
+The metadata is read from the Prefect API by default. The provider also embeds a snapshot of the metadata,
+which can be used with `+"`source = \"embedded\"`"+` when the API does not serve it (for example, in air-gapped installations)
+or to keep plans reproducible for a given provider version.
+<br>
 For more information, see [workers](https://docs.prefect.io/v3/deploy/infrastructure-concepts/workers).
 `,
 			helpers.AllPlans...,
@@ -76,6 +94,7 @@ For more information, see [workers](https://docs.prefect.io/v3/deploy/infrastruc
 				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
 				Optional:    true,
 			},
+			"source": workerMetadataSourceAttribute(),
 			"base_job_configs": schema.SingleNestedAttribute{
 				Computed:    true,
 				Description: "A map of default base job configurations (JSON) for each of the primary worker types",
@@ -171,17 +190,9 @@ func (d *WorkerMetadataDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	client, err := d.client.Collections(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
-	if err != nil {
-		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Collections", err))
-
-		return
-	}
-
-	workerTypeByPackage, err := client.GetWorkerMetadataViews(ctx)
-	if err != nil {
-		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Worker Metadata", "get", err))
-
+	workerTypeByPackage, diags := getWorkerMetadata(ctx, d.client, model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.Source.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -243,3 +254,67 @@ func (d *WorkerMetadataDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 }
+
+// workerMetadataSourceAttribute returns the schema attribute used to select
+// where worker metadata is read from.
+func workerMetadataSourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: "Where to read the worker metadata from: " +
+			"`server` to read it from the Prefect API, " +
+			"`embedded` to use the snapshot embedded in the provider, " +
+			"or `auto` to read it from the Prefect API and fall back to the embedded snapshot if that fails. " +
+			"Defaults to `server`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(workerMetadataSourceServer, workerMetadataSourceEmbedded, workerMetadataSourceAuto),
+		},
+	}
+}
+
+// getWorkerMetadata returns the worker metadata from the given source.
+// An empty source reads the worker metadata from the server.
+func getWorkerMetadata(ctx context.Context, prefectClient api.PrefectClient, accountID, workspaceID uuid.UUID, source string) (api.WorkerTypeByPackage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if source != workerMetadataSourceEmbedded {
+		workerTypeByPackage, err := getServerWorkerMetadata(ctx, prefectClient, accountID, workspaceID)
+		if err == nil {
+			return workerTypeByPackage, diags
+		}
+
+		if source != workerMetadataSourceAuto {
+			diags.Append(err)
+
+			return nil, diags
+		}
+
+		diags.AddWarning(
+			"Using embedded worker metadata",
+			fmt.Sprintf("Could not read the worker metadata from the Prefect API, so the snapshot embedded in the provider is used instead: %s", err.Detail()),
+		)
+	}
+
+	snapshot, err := workermetadata.Embedded()
+	if err != nil {
+		diags.AddError("Error reading embedded worker metadata", err.Error())
+
+		return nil, diags
+	}
+
+	return snapshot.WorkerMetadata, diags
+}
+
+// getServerWorkerMetadata returns the worker metadata served by the Prefect API.
+func getServerWorkerMetadata(ctx context.Context, prefectClient api.PrefectClient, accountID, workspaceID uuid.UUID) (api.WorkerTypeByPackage, diag.Diagnostic) {
+	client, err := prefectClient.Collections(accountID, workspaceID)
+	if err != nil {
+		return nil, helpers.CreateClientErrorDiagnostic("Collections", err)
+	}
+
+	workerTypeByPackage, err := client.GetWorkerMetadataViews(ctx)
+	if err != nil {
+		return nil, helpers.ResourceClientErrorDiagnostic("Worker Metadata", "get", err)
+	}
+
+	return workerTypeByPackage, nil
+}
@@ -21,6 +21,18 @@ data "prefect_worker_metadata" "default" {
 `, workspace, workspaceIDArg)
 }
 
+func fixtureAccWorkerMetadtataEmbedded(workspace, workspaceIDArg string) string {
+	return fmt.Sprintf(`
+%s
+
+data "prefect_worker_metadata" "embedded" {
+	source = "embedded"
+
+	%s
+}
+`, workspace, workspaceIDArg)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccDatasource_worker_metadata(t *testing.T) {
 	datasourceName := "data.prefect_worker_metadata.default"
@@ -55,5 +67,13 @@ func TestAccDatasource_worker_metadata(t *testing.T) {
 					testutils.ExpectKnownValueNotNull(datasourceName, "base_job_configs.prefect_managed"),
 				},
 			},
+			{
+				Config: fixtureAccWorkerMetadtataEmbedded(workspace.Resource, workspace.IDArg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNotNull("data.prefect_worker_metadata.embedded", "base_job_configs.kubernetes"),
+					testutils.ExpectKnownValueNotNull("data.prefect_worker_metadata.embedded", "base_job_configs.process"),
+					testutils.ExpectKnownValueNotNull("data.prefect_worker_metadata.embedded", "base_job_configs.prefect_managed"),
+				},
+			},
 		}})
 }
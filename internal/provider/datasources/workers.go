@@ -0,0 +1,219 @@
+package datasources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+const (
+	// workerStatusOnline is the status of a worker that sent a heartbeat recently.
+	workerStatusOnline = "ONLINE"
+	// workerStatusOffline is the status of a worker that missed its heartbeats.
+	workerStatusOffline = "OFFLINE"
+)
+
+var _ = datasource.DataSourceWithConfigure(&WorkersDataSource{})
+
+// WorkersDataSource contains state for the data source.
+type WorkersDataSource struct {
+	client api.PrefectClient
+}
+
+// WorkersDataSourceModel defines the Terraform data source model.
+type WorkersDataSourceModel struct {
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	WorkPoolName types.String `tfsdk:"work_pool_name"`
+	Status       types.String `tfsdk:"status"`
+
+	Workers []WorkersWorkerModel `tfsdk:"workers"`
+}
+
+// WorkersWorkerModel defines a single worker returned by the data source.
+type WorkersWorkerModel struct {
+	BaseModel
+
+	Name                     types.String               `tfsdk:"name"`
+	WorkPoolID               customtypes.UUIDValue      `tfsdk:"work_pool_id"`
+	Status                   types.String               `tfsdk:"status"`
+	LastHeartbeatTime        customtypes.TimestampValue `tfsdk:"last_heartbeat_time"`
+	HeartbeatIntervalSeconds types.Int64                `tfsdk:"heartbeat_interval_seconds"`
+}
+
+// NewWorkersDataSource returns a new WorkersDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkersDataSource() datasource.DataSource {
+	return &WorkersDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workers"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *WorkersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get information about the Workers polling a Work Pool.
+<br>
+Use this data source to check that a Work Pool has live workers, for example in a `+"`check`"+` block.
+A worker is `+"`ONLINE`"+` while it sends heartbeats, and `+"`OFFLINE`"+` once it has missed several of them.
+<br>
+For more information, see [workers](https://docs.prefect.io/v3/deploy/infrastructure-concepts/workers).
+`,
+			helpers.AllPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				Optional:    true,
+			},
+			"work_pool_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the work pool",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return workers with this status, either `ONLINE` or `OFFLINE`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(workerStatusOnline, workerStatusOffline),
+				},
+			},
+			"workers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Workers returned by the server, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Worker ID (UUID)",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was created (RFC3339)",
+						},
+						"updated": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of when the resource was updated (RFC3339)",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the worker",
+						},
+						"work_pool_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Work pool ID (UUID)",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the worker, either `ONLINE` or `OFFLINE`",
+						},
+						"last_heartbeat_time": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.TimestampType{},
+							Description: "Timestamp of the last heartbeat sent by the worker (RFC3339)",
+						},
+						"heartbeat_interval_seconds": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of seconds between the heartbeats of the worker",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model WorkersDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.Workers(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workers", err))
+
+		return
+	}
+
+	filter := api.WorkerFilter{}
+	if !model.Status.IsNull() {
+		filter.Status = []string{model.Status.ValueString()}
+	}
+
+	workers, err := client.List(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workers", "list", err))
+
+		return
+	}
+
+	sort.SliceStable(workers, func(i, j int) bool {
+		return workers[i].Name < workers[j].Name
+	})
+
+	model.Workers = make([]WorkersWorkerModel, 0, len(workers))
+	for _, worker := range workers {
+		model.Workers = append(model.Workers, WorkersWorkerModel{
+			BaseModel: BaseModel{
+				ID:      customtypes.NewUUIDValue(worker.ID),
+				Created: customtypes.NewTimestampPointerValue(worker.Created),
+				Updated: customtypes.NewTimestampPointerValue(worker.Updated),
+			},
+			Name:                     types.StringValue(worker.Name),
+			WorkPoolID:               customtypes.NewUUIDValue(worker.WorkPoolID),
+			Status:                   types.StringValue(worker.Status),
+			LastHeartbeatTime:        customtypes.NewTimestampPointerValue(worker.LastHeartbeatTime),
+			HeartbeatIntervalSeconds: types.Int64PointerValue(worker.HeartbeatIntervalSeconds),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
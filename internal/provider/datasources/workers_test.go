@@ -0,0 +1,84 @@
+package datasources_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type workersFixtureConfig struct {
+	WorkspaceResource string
+	WorkspaceIDArg    string
+	WorkPoolName      string
+	Status            string
+}
+
+func fixtureAccWorkers(cfg workersFixtureConfig) string {
+	tmpl := `
+{{.WorkspaceResource}}
+
+resource "prefect_work_pool" "test" {
+	name = "{{.WorkPoolName}}"
+	type = "process"
+	{{.WorkspaceIDArg}}
+}
+
+data "prefect_workers" "test" {
+	work_pool_name = prefect_work_pool.test.name
+	{{- if .Status}}
+	status = "{{.Status}}"
+	{{- end}}
+	{{.WorkspaceIDArg}}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_workers(t *testing.T) {
+	datasourceName := "data.prefect_workers.test"
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := workersFixtureConfig{
+		WorkspaceResource: workspace.Resource,
+		WorkspaceIDArg:    workspace.IDArg,
+		WorkPoolName:      testutils.NewRandomPrefixedString(),
+	}
+
+	cfgOnline := cfg
+	cfgOnline.Status = "ONLINE"
+
+	cfgInvalidStatus := cfg
+	cfgInvalidStatus.Status = "BUSY"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that no worker polls a new work pool
+				Config: fixtureAccWorkers(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(datasourceName, "workers", 0),
+				},
+			},
+			{
+				// Check filtering on the worker status
+				Config: fixtureAccWorkers(cfgOnline),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(datasourceName, "status", "ONLINE"),
+					testutils.ExpectKnownValueListSize(datasourceName, "workers", 0),
+				},
+			},
+			{
+				// Check that an unknown status is rejected
+				Config:      fixtureAccWorkers(cfgInvalidStatus),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package datasources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var _ = datasource.DataSourceWithConfigure(&WorkspaceScopesDataSource{})
+
+// WorkspaceScopesDataSource contains state for the data source.
+type WorkspaceScopesDataSource struct {
+	client api.PrefectClient
+}
+
+// WorkspaceScopesDataSourceModel defines the Terraform data source model.
+type WorkspaceScopesDataSourceModel struct {
+	Names  types.List `tfsdk:"names"`
+	Scopes types.List `tfsdk:"scopes"`
+}
+
+// NewWorkspaceScopesDataSource returns a new WorkspaceScopesDataSource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkspaceScopesDataSource() datasource.DataSource {
+	return &WorkspaceScopesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *WorkspaceScopesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_scopes"
+}
+
+// Configure initializes runtime state for the data source.
+func (d *WorkspaceScopesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("data source", req.ProviderData))
+
+		return
+	}
+
+	d.client = client
+}
+
+// Schema defines the schema for the data source.
+func (d *WorkspaceScopesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+Get the catalogue of scopes that can be granted to a Workspace Role.
+<br>
+Use this data source to discover the available scopes when building a `+"`prefect_workspace_role`"+`.
+<br>
+For more information, see [manage workspaces](https://docs.prefect.io/v3/manage/cloud/workspaces).
+`,
+			helpers.PlanPro,
+			helpers.PlanEnterprise,
+		),
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Computed:    true,
+				Description: "Names of all available scopes, sorted alphabetically",
+				ElementType: types.StringType,
+			},
+			"scopes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Scopes returned by the server, sorted alphabetically by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the scope, as used in `prefect_workspace_role.scopes`",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the permissions granted by the scope",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *WorkspaceScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model WorkspaceScopesDataSourceModel
+
+	// Populate the model from data source configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := d.client.WorkspaceScopes()
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Scopes", err))
+
+		return
+	}
+
+	scopes, err := client.List(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Workspace Scopes", "list", err))
+
+		return
+	}
+
+	sort.Slice(scopes, func(i, j int) bool {
+		return scopes[i].Name < scopes[j].Name
+	})
+
+	attributeTypes := map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+	}
+
+	names := make([]string, 0, len(scopes))
+	scopeObjects := make([]attr.Value, 0, len(scopes))
+	for _, scope := range scopes {
+		names = append(names, scope.Name)
+
+		scopeObject, diag := types.ObjectValue(attributeTypes, map[string]attr.Value{
+			"name":        types.StringValue(scope.Name),
+			"description": types.StringValue(scope.Description),
+		})
+		resp.Diagnostics.Append(diag...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		scopeObjects = append(scopeObjects, scopeObject)
+	}
+
+	list, diag := types.ListValue(types.ObjectType{AttrTypes: attributeTypes}, scopeObjects)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Scopes = list
+
+	namesList, diag := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Names = namesList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
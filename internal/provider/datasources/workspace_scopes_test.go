@@ -0,0 +1,32 @@
+package datasources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccDatasource_workspace_scopes(t *testing.T) {
+	// Workspace scopes are not supported in OSS.
+	testutils.SkipTestsIfOSS(t)
+
+	dataSourceName := "data.prefect_workspace_scopes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "prefect_workspace_scopes" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSizeMin(dataSourceName, "names", 1),
+					testutils.ExpectKnownValueListSizeMin(dataSourceName, "scopes", 1),
+					testutils.ExpectKnownValueNotNull(dataSourceName, "scopes.0.name"),
+				},
+			},
+		},
+	})
+}
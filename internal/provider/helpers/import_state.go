@@ -71,3 +71,21 @@ func ImportStateByID(ctx context.Context, req resource.ImportStateRequest, resp
 func ImportStateByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importState(ctx, req, resp, "name")
 }
+
+// ImportStateByWorkPoolName imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "work_pool_name,workspace_id"
+// - "work_pool_name"
+func ImportStateByWorkPoolName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, "work_pool_name")
+}
+
+// ImportStateByManagedTag imports the resource into Terraform state.
+//
+// Allows input values in the form of:
+// - "managed_tag,workspace_id"
+// - "managed_tag"
+func ImportStateByManagedTag(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, "managed_tag")
+}
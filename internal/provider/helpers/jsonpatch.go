@@ -0,0 +1,355 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSONPatchOperation is a single operation of an RFC 6902 JSON patch.
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyJSONMergePatch applies an RFC 7396 JSON merge patch to a decoded JSON document
+// and returns the patched document. Objects in the patch are merged recursively,
+// null values remove the corresponding key and any other value replaces the target.
+func ApplyJSONMergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	result := map[string]any{}
+	if targetObject, ok := target.(map[string]any); ok {
+		result = maps.Clone(targetObject)
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(result, key)
+
+			continue
+		}
+
+		result[key] = ApplyJSONMergePatch(result[key], value)
+	}
+
+	return result
+}
+
+// ApplyJSONPatch applies the operations of an RFC 6902 JSON patch, in order,
+// to a decoded JSON document and returns the patched document.
+// The document may be modified in place.
+func ApplyJSONPatch(document any, operations []JSONPatchOperation) (any, error) {
+	var err error
+
+	for i, operation := range operations {
+		document, err = applyJSONPatchOperation(document, operation)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+
+	return document, nil
+}
+
+func applyJSONPatchOperation(document any, operation JSONPatchOperation) (any, error) {
+	path, err := parseJSONPointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operation.Op {
+	case "add", "replace", "test":
+		if len(operation.Value) == 0 {
+			return nil, fmt.Errorf("missing value")
+		}
+
+		var value any
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return nil, fmt.Errorf("invalid value: %w", err)
+		}
+
+		switch operation.Op {
+		case "add":
+			return jsonPointerAdd(document, path, value)
+		case "replace":
+			return jsonPointerReplace(document, path, value)
+		default:
+			current, err := jsonPointerGet(document, path)
+			if err != nil {
+				return nil, err
+			}
+
+			if !reflect.DeepEqual(current, value) {
+				return nil, fmt.Errorf("test failed: value does not match")
+			}
+
+			return document, nil
+		}
+
+	case "remove":
+		document, _, err = jsonPointerRemove(document, path)
+
+		return document, err
+
+	case "move", "copy":
+		from, err := parseJSONPointer(operation.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+
+		var value any
+		if operation.Op == "move" {
+			if len(from) < len(path) && slices.Equal(from, path[:len(from)]) {
+				return nil, fmt.Errorf("cannot move a value into one of its children")
+			}
+
+			document, value, err = jsonPointerRemove(document, from)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			value, err = jsonPointerGet(document, from)
+			if err != nil {
+				return nil, err
+			}
+
+			// Copy the value, so that later operations on either location do not affect the other.
+			value, err = deepCopyJSON(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return jsonPointerAdd(document, path, value)
+
+	default:
+		return nil, fmt.Errorf("unsupported operation %q", operation.Op)
+	}
+}
+
+// parseJSONPointer splits an RFC 6901 JSON pointer into its unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// jsonArrayIndex parses an array index token. When allowEnd is set,
+// the index may point one past the last element, and `-` refers to that position.
+func jsonArrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+
+	if index > length || (index == length && !allowEnd) {
+		return 0, fmt.Errorf("array index %d out of bounds", index)
+	}
+
+	return index, nil
+}
+
+// jsonPointerUpdate walks the document to the container referenced by all but the
+// last token, and replaces that container with the result of update.
+func jsonPointerUpdate(node any, path []string, update func(container any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return update(node, path[0])
+	}
+
+	switch container := node.(type) {
+	case map[string]any:
+		child, ok := container[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("path not found: member %q does not exist", path[0])
+		}
+
+		updated, err := jsonPointerUpdate(child, path[1:], update)
+		if err != nil {
+			return nil, err
+		}
+
+		container[path[0]] = updated
+
+		return container, nil
+
+	case []any:
+		index, err := jsonArrayIndex(path[0], len(container), false)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := jsonPointerUpdate(container[index], path[1:], update)
+		if err != nil {
+			return nil, err
+		}
+
+		container[index] = updated
+
+		return container, nil
+
+	default:
+		return nil, fmt.Errorf("path not found: %q is not an object or array", path[0])
+	}
+}
+
+func jsonPointerGet(node any, path []string) (any, error) {
+	for _, token := range path {
+		switch container := node.(type) {
+		case map[string]any:
+			child, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("path not found: member %q does not exist", token)
+			}
+
+			node = child
+
+		case []any:
+			index, err := jsonArrayIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+
+			node = container[index]
+
+		default:
+			return nil, fmt.Errorf("path not found: %q is not an object or array", token)
+		}
+	}
+
+	return node, nil
+}
+
+func jsonPointerAdd(document any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return jsonPointerUpdate(document, path, func(node any, token string) (any, error) {
+		switch container := node.(type) {
+		case map[string]any:
+			container[token] = value
+
+			return container, nil
+
+		case []any:
+			index, err := jsonArrayIndex(token, len(container), true)
+			if err != nil {
+				return nil, err
+			}
+
+			return slices.Insert(container, index, value), nil
+
+		default:
+			return nil, fmt.Errorf("cannot add member %q to a value that is not an object or array", token)
+		}
+	})
+}
+
+func jsonPointerReplace(document any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return jsonPointerUpdate(document, path, func(node any, token string) (any, error) {
+		switch container := node.(type) {
+		case map[string]any:
+			if _, ok := container[token]; !ok {
+				return nil, fmt.Errorf("path not found: member %q does not exist", token)
+			}
+
+			container[token] = value
+
+			return container, nil
+
+		case []any:
+			index, err := jsonArrayIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+
+			container[index] = value
+
+			return container, nil
+
+		default:
+			return nil, fmt.Errorf("path not found: %q is not an object or array", token)
+		}
+	})
+}
+
+func jsonPointerRemove(document any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	var removed any
+
+	document, err := jsonPointerUpdate(document, path, func(node any, token string) (any, error) {
+		switch container := node.(type) {
+		case map[string]any:
+			value, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("path not found: member %q does not exist", token)
+			}
+
+			removed = value
+			delete(container, token)
+
+			return container, nil
+
+		case []any:
+			index, err := jsonArrayIndex(token, len(container), false)
+			if err != nil {
+				return nil, err
+			}
+
+			removed = container[index]
+
+			return slices.Delete(container, index, index+1), nil
+
+		default:
+			return nil, fmt.Errorf("path not found: %q is not an object or array", token)
+		}
+	})
+
+	return document, removed, err
+}
+
+func deepCopyJSON(value any) (any, error) {
+	byteSlice, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy value: %w", err)
+	}
+
+	var copied any
+	if err := json.Unmarshal(byteSlice, &copied); err != nil {
+		return nil, fmt.Errorf("failed to copy value: %w", err)
+	}
+
+	return copied, nil
+}
@@ -0,0 +1,241 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func decodeJSON(t *testing.T, s string) any {
+	t.Helper()
+
+	var value any
+	if err := json.Unmarshal([]byte(s), &value); err != nil {
+		t.Fatalf("failed to decode %q: %v", s, err)
+	}
+
+	return value
+}
+
+func encodeJSON(t *testing.T, value any) string {
+	t.Helper()
+
+	byteSlice, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("failed to encode %v: %v", value, err)
+	}
+
+	return string(byteSlice)
+}
+
+func TestApplyJSONMergePatch(t *testing.T) {
+	t.Parallel()
+
+	// Test cases from RFC 7396, Appendix A.
+	tests := []struct {
+		target   string
+		patch    string
+		expected string
+	}{
+		{target: `{"a":"b"}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"b":"c"}`, expected: `{"a":"b","b":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"a":null}`, expected: `{}`},
+		{target: `{"a":"b","b":"c"}`, patch: `{"a":null}`, expected: `{"b":"c"}`},
+		{target: `{"a":["b"]}`, patch: `{"a":"c"}`, expected: `{"a":"c"}`},
+		{target: `{"a":"c"}`, patch: `{"a":["b"]}`, expected: `{"a":["b"]}`},
+		{target: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, expected: `{"a":{"b":"d"}}`},
+		{target: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, expected: `{"a":[1]}`},
+		{target: `["a","b"]`, patch: `["c","d"]`, expected: `["c","d"]`},
+		{target: `{"a":"b"}`, patch: `["c"]`, expected: `["c"]`},
+		{target: `{"a":"foo"}`, patch: `null`, expected: `null`},
+		{target: `{"a":"foo"}`, patch: `"bar"`, expected: `"bar"`},
+		{target: `{"e":null}`, patch: `{"a":1}`, expected: `{"a":1,"e":null}`},
+		{target: `[1,2]`, patch: `{"a":"b","c":null}`, expected: `{"a":"b"}`},
+		{target: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, expected: `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target+" + "+tt.patch, func(t *testing.T) {
+			t.Parallel()
+
+			got := encodeJSON(t, helpers.ApplyJSONMergePatch(decodeJSON(t, tt.target), decodeJSON(t, tt.patch)))
+			if got != tt.expected {
+				t.Errorf("ApplyJSONMergePatch() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "add object member",
+			document: `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz","value":"qux"}]`,
+			expected: `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:     "add array element",
+			document: `{"foo":["bar","baz"]}`,
+			patch:    `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			expected: `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:     "append array element",
+			document: `{"foo":["bar"]}`,
+			patch:    `[{"op":"add","path":"/foo/-","value":{"a":1}}]`,
+			expected: `{"foo":["bar",{"a":1}]}`,
+		},
+		{
+			name:     "add null value",
+			document: `{}`,
+			patch:    `[{"op":"add","path":"/foo","value":null}]`,
+			expected: `{"foo":null}`,
+		},
+		{
+			name:     "remove object member",
+			document: `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"remove","path":"/baz"}]`,
+			expected: `{"foo":"bar"}`,
+		},
+		{
+			name:     "remove array element",
+			document: `{"foo":["bar","qux","baz"]}`,
+			patch:    `[{"op":"remove","path":"/foo/1"}]`,
+			expected: `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:     "replace value",
+			document: `{"baz":"qux","foo":"bar"}`,
+			patch:    `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			expected: `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:     "move value",
+			document: `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch:    `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			expected: `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:     "move array element",
+			document: `{"foo":["all","grass","cows","eat"]}`,
+			patch:    `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			expected: `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:     "copy value is independent",
+			document: `{"a":{"b":1}}`,
+			patch:    `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`,
+			expected: `{"a":{"b":1},"c":{"b":2}}`,
+		},
+		{
+			name:     "test passes",
+			document: `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch:    `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			expected: `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:     "escaped pointer tokens",
+			document: `{"a/b":{"m~n":1}}`,
+			patch:    `[{"op":"replace","path":"/a~1b/m~0n","value":2}]`,
+			expected: `{"a/b":{"m~n":2}}`,
+		},
+		{
+			name:     "replace whole document",
+			document: `{"a":1}`,
+			patch:    `[{"op":"replace","path":"","value":[1]}]`,
+			expected: `[1]`,
+		},
+		{
+			name:     "test fails",
+			document: `{"baz":"qux"}`,
+			patch:    `[{"op":"test","path":"/baz","value":"bar"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "remove missing member",
+			document: `{"foo":"bar"}`,
+			patch:    `[{"op":"remove","path":"/baz"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "add to missing parent",
+			document: `{"foo":"bar"}`,
+			patch:    `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "array index out of bounds",
+			document: `{"foo":["bar"]}`,
+			patch:    `[{"op":"add","path":"/foo/2","value":"qux"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "array index with leading zero",
+			document: `{"foo":["bar","baz"]}`,
+			patch:    `[{"op":"replace","path":"/foo/01","value":"qux"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "move into own child",
+			document: `{"a":{"b":1}}`,
+			patch:    `[{"op":"move","from":"/a","path":"/a/c"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "missing value",
+			document: `{}`,
+			patch:    `[{"op":"add","path":"/a"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "unsupported operation",
+			document: `{}`,
+			patch:    `[{"op":"merge","path":"/a","value":1}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid pointer",
+			document: `{}`,
+			patch:    `[{"op":"add","path":"a","value":1}]`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var operations []helpers.JSONPatchOperation
+			if err := json.Unmarshal([]byte(tt.patch), &operations); err != nil {
+				t.Fatalf("failed to decode patch: %v", err)
+			}
+
+			result, err := helpers.ApplyJSONPatch(decodeJSON(t, tt.document), operations)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ApplyJSONPatch() = %s, want error", encodeJSON(t, result))
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ApplyJSONPatch() error = %v", err)
+			}
+
+			if got := encodeJSON(t, result); got != tt.expected {
+				t.Errorf("ApplyJSONPatch() = %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,8 +14,53 @@ const (
 	DefaultStabilizationAttempts = 10
 	// DefaultStabilizationDelay is the default delay between retry attempts.
 	DefaultStabilizationDelay = 500 * time.Millisecond
+	// DefaultStabilizationMaxDelay is the maximum delay between retry attempts
+	// when retrying until the context deadline.
+	DefaultStabilizationMaxDelay = 10 * time.Second
 )
 
+// notFoundRetriesDisabledContextKey marks a context whose requests should not retry 404 responses.
+type notFoundRetriesDisabledContextKey struct{}
+
+// errResourceStillExists is returned while a deleted resource can still be fetched.
+var errResourceStillExists = errors.New("resource still exists")
+
+// ContextWithoutNotFoundRetries returns a copy of the context that tells the client
+// not to retry requests that fail with a 404. This is used when a 404 is the expected
+// outcome, such as when waiting for a deleted resource to disappear.
+func ContextWithoutNotFoundRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, notFoundRetriesDisabledContextKey{}, true)
+}
+
+// NotFoundRetriesDisabled reports whether the context was created by ContextWithoutNotFoundRetries.
+func NotFoundRetriesDisabled(ctx context.Context) bool {
+	disabled, ok := ctx.Value(notFoundRetriesDisabledContextKey{}).(bool)
+
+	return ok && disabled
+}
+
+// stabilizationRetryOptions returns the retry options shared by the stabilization helpers.
+//
+// If the context carries a deadline, such as one derived from a resource's `timeouts` block,
+// attempts continue until that deadline is reached. Otherwise, the number of attempts
+// is capped at DefaultStabilizationAttempts.
+func stabilizationRetryOptions(ctx context.Context) []retry.Option {
+	opts := []retry.Option{
+		retry.Context(ctx),
+		retry.Delay(DefaultStabilizationDelay),
+		retry.LastErrorOnly(true),
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return append(opts,
+			retry.Attempts(0), // retry until the context deadline is reached
+			retry.MaxDelay(DefaultStabilizationMaxDelay),
+		)
+	}
+
+	return append(opts, retry.Attempts(DefaultStabilizationAttempts))
+}
+
 // WaitForResourceStabilization is a generic helper that retries fetching a resource
 // until a comparison function indicates the state is stable.
 //
@@ -29,8 +75,8 @@ const (
 //   - isStableFunc: Function that returns nil if the state is stable, or an error describing why it's not
 //
 // The function will retry up to DefaultStabilizationAttempts times with DefaultStabilizationDelay
-// between attempts. If all retries are exhausted, it returns the last fetched state anyway,
-// allowing Terraform to detect any remaining drift.
+// between attempts, or until the context deadline if one is set. If all retries are exhausted,
+// it returns the last fetched state anyway, allowing Terraform to detect any remaining drift.
 //
 // Example usage:
 //
@@ -73,9 +119,7 @@ func WaitForResourceStabilization[T any](
 
 			return nil
 		},
-		retry.Attempts(DefaultStabilizationAttempts),
-		retry.Delay(DefaultStabilizationDelay),
-		retry.LastErrorOnly(true),
+		stabilizationRetryOptions(ctx)...,
 	)
 
 	if retryErr != nil {
@@ -105,7 +149,8 @@ func WaitForResourceStabilization[T any](
 //   - compareFunc: Function that returns true if two consecutive states are equal
 //
 // The function will retry up to DefaultStabilizationAttempts times with DefaultStabilizationDelay
-// between attempts. If all retries are exhausted, it returns the last fetched state anyway.
+// between attempts, or until the context deadline if one is set. If all retries are exhausted,
+// it returns the last fetched state anyway.
 //
 // Example usage for automation's match_related field:
 //
@@ -154,9 +199,7 @@ func WaitForResourceStabilizationByComparison[T any](
 
 			return fmt.Errorf("resource state still changing")
 		},
-		retry.Attempts(DefaultStabilizationAttempts),
-		retry.Delay(DefaultStabilizationDelay),
-		retry.LastErrorOnly(true),
+		stabilizationRetryOptions(ctx)...,
 	)
 
 	if retryErr != nil {
@@ -172,3 +215,57 @@ func WaitForResourceStabilizationByComparison[T any](
 
 	return resource, nil
 }
+
+// WaitForResourceDeletion is a counterpart to WaitForResourceStabilization that retries
+// fetching a deleted resource until the API reports it as not found (404).
+//
+// Prefect removes some objects, and the objects cascaded from them, asynchronously
+// after accepting the DELETE request. Waiting for the 404 makes destroy/recreate cycles
+// reliable, for example when recreating a workspace with the same handle in one apply.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - fetchFunc: Function that fetches the current resource state from the API
+//
+// The function will retry up to DefaultStabilizationAttempts times with DefaultStabilizationDelay
+// between attempts, or until the context deadline if one is set. Unlike the stabilization
+// helpers, an error is returned if the resource still exists once all retries are exhausted.
+//
+// Example usage:
+//
+//	err := WaitForResourceDeletion(
+//	    ctx,
+//	    func(ctx context.Context) (*api.Workspace, error) {
+//	        return client.Get(ctx, id)
+//	    },
+//	)
+func WaitForResourceDeletion[T any](
+	ctx context.Context,
+	fetchFunc func(context.Context) (T, error),
+) error {
+	// A 404 is the expected outcome here, so there is no point in letting the
+	// client retry it before reporting back.
+	fetchCtx := ContextWithoutNotFoundRetries(ctx)
+
+	retryErr := retry.Do(
+		func() error {
+			_, err := fetchFunc(fetchCtx)
+			if err == nil {
+				return errResourceStillExists
+			}
+
+			if Is404Error(err) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to fetch resource: %w", err)
+		},
+		stabilizationRetryOptions(ctx)...,
+	)
+
+	if retryErr != nil {
+		return fmt.Errorf("failed to confirm resource deletion: %w", retryErr)
+	}
+
+	return nil
+}
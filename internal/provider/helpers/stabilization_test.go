@@ -0,0 +1,88 @@
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func TestWaitForResourceDeletion(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := fmt.Errorf("status_code=404, error=<nil>, body={\"detail\": \"not found\"}")
+
+	t.Run("returns once the resource is not found", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+
+		err := helpers.WaitForResourceDeletion(context.Background(), func(ctx context.Context) (*string, error) {
+			assert.True(t, helpers.NotFoundRetriesDisabled(ctx), "404 retries should be disabled while waiting for deletion")
+
+			attempts++
+			if attempts < 3 {
+				name := "still-here"
+
+				return &name, nil
+			}
+
+			return nil, errNotFound
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("treats ErrNotFound as deleted", func(t *testing.T) {
+		t.Parallel()
+
+		err := helpers.WaitForResourceDeletion(context.Background(), func(_ context.Context) (*string, error) {
+			return nil, fmt.Errorf("lookup failed: %w", helpers.ErrNotFound)
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns an error if the resource still exists at the deadline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := helpers.ContextWithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		err := helpers.WaitForResourceDeletion(ctx, func(_ context.Context) (*string, error) {
+			name := "still-here"
+
+			return &name, nil
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to confirm resource deletion")
+	})
+
+	t.Run("returns an error if fetching keeps failing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := helpers.ContextWithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		err := helpers.WaitForResourceDeletion(ctx, func(_ context.Context) (*string, error) {
+			return nil, errors.New("status_code=500")
+		})
+
+		require.Error(t, err)
+	})
+}
+
+func TestContextWithoutNotFoundRetries(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, helpers.NotFoundRetriesDisabled(context.Background()))
+	assert.True(t, helpers.NotFoundRetriesDisabled(helpers.ContextWithoutNotFoundRetries(context.Background())))
+}
@@ -0,0 +1,41 @@
+package helpers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeoutsBlock returns the `timeouts` block supported by all resources.
+// Any timeout that is not configured falls back to the provider's `default_timeouts`.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.BlockAll(ctx)
+}
+
+// NullTimeouts returns a null `timeouts` block value, for use when a
+// resource model is built from scratch (eg. during a state upgrade).
+func NullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// ContextWithTimeout returns a copy of the context that is canceled once the
+// timeout elapses. A zero timeout leaves the context without a deadline, so
+// client retries and stabilization fall back to their default limits.
+func ContextWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,84 @@
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+func TestContextWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero timeout leaves the context without a deadline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := helpers.ContextWithTimeout(context.Background(), 0)
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("positive timeout sets a deadline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := helpers.ContextWithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	})
+}
+
+func TestNullTimeouts(t *testing.T) {
+	t.Parallel()
+
+	value := helpers.NullTimeouts()
+
+	assert.True(t, value.IsNull())
+	assert.ElementsMatch(t, []string{"create", "read", "update", "delete"}, keys(value.AttributeTypes(context.Background())))
+}
+
+func TestWaitForResourceStabilization_RespectsContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := helpers.ContextWithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	attempts := 0
+	start := time.Now()
+
+	result, err := helpers.WaitForResourceStabilization(
+		ctx,
+		func(_ context.Context) (int, error) {
+			attempts++
+
+			return attempts, nil
+		},
+		func(_ int) error {
+			return errors.New("never stable")
+		},
+	)
+
+	// The last fetched state is returned once the deadline is reached,
+	// so Terraform can detect any remaining drift.
+	require.NoError(t, err)
+	assert.Equal(t, attempts, result)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func keys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+
+	return result
+}
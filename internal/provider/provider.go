@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -17,13 +18,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/prefecthq/terraform-provider-prefect/internal/client"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/actions"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/datasources"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/resources"
 )
 
-var _ = provider.Provider(&PrefectProvider{})
+var (
+	_ = provider.Provider(&PrefectProvider{})
+	_ = provider.ProviderWithActions(&PrefectProvider{})
+)
 
 const (
 	envAccountID           = "PREFECT_CLOUD_ACCOUNT_ID"
@@ -109,6 +114,31 @@ func (p *PrefectProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 					" This allows you to use a custom profiles file location.",
 				Optional: true,
 			},
+			"default_timeouts": schema.SingleNestedAttribute{
+				Description: "Default timeouts for resource operations, used when a resource does not configure" +
+					" the matching value in its `timeouts` block. Each value is a [duration](https://pkg.go.dev/time#ParseDuration)" +
+					" such as `30s` or `10m`. When no timeout is configured, API retries and resource stabilization" +
+					" use their built-in limits.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Description: "Default timeout for create operations.",
+						Optional:    true,
+					},
+					"read": schema.StringAttribute{
+						Description: "Default timeout for read operations.",
+						Optional:    true,
+					},
+					"update": schema.StringAttribute{
+						Description: "Default timeout for update operations.",
+						Optional:    true,
+					},
+					"delete": schema.StringAttribute{
+						Description: "Default timeout for delete operations.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -125,6 +155,20 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	// If the values used to reach the Prefect API are not yet known (eg. the workspace_id
+	// refers to a prefect_workspace created in the same run), and Terraform supports
+	// deferred actions, defer all resources and data sources until they are known.
+	// https://developer.hashicorp.com/terraform/plugin/framework/deferred-actions
+	if req.ClientCapabilities.DeferralAllowed && hasUnknownConnectionValues(config) {
+		tflog.Info(ctx, "Provider configuration contains unknown values, deferring all resources and data sources")
+
+		resp.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+
+		return
+	}
+
 	// Ensure that all configuration values passed in to provider are known
 	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/terraform-concepts#unknown-values
 	if config.Endpoint.IsUnknown() {
@@ -367,6 +411,12 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	defaultTimeouts, diags := ParseDefaultTimeouts(ctx, config.DefaultTimeouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "prefect_profile", profileName)
 	ctx = tflog.SetField(ctx, "prefect_profile_file", profileFilePath)
 	ctx = tflog.SetField(ctx, "prefect_endpoint", endpoint)
@@ -394,6 +444,7 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 		client.WithDefaults(accountID, workspaceID),
 		client.WithCsrfEnabled(csrfEnabled),
 		client.WithCustomHeaders(customHeadersMap),
+		client.WithDefaultTimeouts(defaultTimeouts),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -406,13 +457,31 @@ func (p *PrefectProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 	p.client = prefectClient
 
-	// Pass client to DataSource and Resource type Configure methods
+	// Pass client to DataSource, Resource and Action type Configure methods
 	resp.DataSourceData = prefectClient
 	resp.ResourceData = prefectClient
+	resp.ActionData = prefectClient
 
 	tflog.Info(ctx, "Configured Prefect client", map[string]any{"success": true})
 }
 
+// hasUnknownConnectionValues reports whether any of the configuration values
+// that determine which Prefect API, account or workspace to talk to are unknown.
+func hasUnknownConnectionValues(config *PrefectProviderModel) bool {
+	return config.Endpoint.IsUnknown() ||
+		config.APIKey.IsUnknown() ||
+		config.BasicAuthKey.IsUnknown() ||
+		config.AccountID.IsUnknown() ||
+		config.WorkspaceID.IsUnknown()
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *PrefectProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		actions.NewRunDeploymentAction,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -421,21 +490,32 @@ func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.Dat
 		datasources.NewAccountMembersDataSource,
 		datasources.NewAccountRoleDataSource,
 		datasources.NewAutomationDataSource,
+		datasources.NewAutomationsDataSource,
 		datasources.NewBlockDataSource,
+		datasources.NewBlockSchemasDataSource,
+		datasources.NewBlocksDataSource,
+		datasources.NewBlockTypeDataSource,
 		datasources.NewDeploymentDataSource,
+		datasources.NewDeploymentsDataSource,
+		datasources.NewFlowDataSource,
+		datasources.NewFlowsDataSource,
 		datasources.NewGlobalConcurrencyLimitDataSource,
 		datasources.NewServiceAccountDataSource,
 		datasources.NewTeamDataSource,
 		datasources.NewTeamsDataSource,
 		datasources.NewVariableDataSource,
+		datasources.NewVariablesDataSource,
 		datasources.NewWebhookDataSource,
 		datasources.NewWorkerMetadataDataSource,
+		datasources.NewWorkersDataSource,
 		datasources.NewWorkPoolDataSource,
+		datasources.NewWorkPoolJobTemplateDataSource,
 		datasources.NewWorkPoolsDataSource,
 		datasources.NewWorkQueueDataSource,
 		datasources.NewWorkQueuesDataSource,
 		datasources.NewWorkspaceDataSource,
 		datasources.NewWorkspaceRoleDataSource,
+		datasources.NewWorkspaceScopesDataSource,
 	}
 }
 
@@ -443,15 +523,19 @@ func (p *PrefectProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *PrefectProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resources.NewAccountResource,
+		resources.NewAccountInvitationResource,
 		resources.NewAccountMemberResource,
+		resources.NewAccountRoleResource,
 		resources.NewAutomationResource,
 		resources.NewBlockAccessResource,
 		resources.NewBlockResource,
+		resources.NewBlockCollectionResource,
 		resources.NewBlockSchemaResource,
 		resources.NewBlockTypeResource,
 		resources.NewDeploymentAccessResource,
 		resources.NewDeploymentResource,
 		resources.NewDeploymentScheduleResource,
+		resources.NewDeploymentSchedulesResource,
 		resources.NewFlowResource,
 		resources.NewGlobalConcurrencyLimitResource,
 		resources.NewServiceAccountResource,
@@ -462,9 +546,11 @@ func (p *PrefectProvider) Resources(_ context.Context) []func() resource.Resourc
 		resources.NewUserResource,
 		resources.NewUserAPIKeyResource,
 		resources.NewVariableResource,
+		resources.NewVariablesResource,
 		resources.NewWebhookResource,
 		resources.NewWorkPoolResource,
 		resources.NewWorkPoolAccessResource,
+		resources.NewWorkPoolQueuesResource,
 		resources.NewWorkspaceAccessResource,
 		resources.NewWorkspaceResource,
 		resources.NewWorkspaceRoleResource,
@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -15,6 +17,12 @@ import (
 )
 
 func setStringAttr(attrs map[string]tftypes.Value, key string, value types.String) {
+	if value.IsUnknown() {
+		attrs[key] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+		return
+	}
+
 	if !value.IsNull() {
 		attrs[key] = tftypes.NewValue(tftypes.String, value.ValueString())
 	} else {
@@ -31,6 +39,12 @@ func setBoolAttr(attrs map[string]tftypes.Value, key string, value types.Bool) {
 }
 
 func setUUIDAttr(attrs map[string]tftypes.Value, key string, value customtypes.UUIDValue) {
+	if value.IsUnknown() {
+		attrs[key] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+		return
+	}
+
 	if !value.IsNull() {
 		attrs[key] = tftypes.NewValue(tftypes.String, value.ValueString())
 	} else {
@@ -38,6 +52,22 @@ func setUUIDAttr(attrs map[string]tftypes.Value, key string, value customtypes.U
 	}
 }
 
+func setDefaultTimeoutsAttr(attrs map[string]tftypes.Value, typ tftypes.Type, value types.Object) {
+	if value.IsNull() {
+		attrs["default_timeouts"] = tftypes.NewValue(typ, nil)
+
+		return
+	}
+
+	timeouts := make(map[string]tftypes.Value)
+	for key, attrValue := range value.Attributes() {
+		if stringValue, ok := attrValue.(types.String); ok {
+			setStringAttr(timeouts, key, stringValue)
+		}
+	}
+	attrs["default_timeouts"] = tftypes.NewValue(typ, timeouts)
+}
+
 func newTestConfigureRequest(t *testing.T, model *provider.PrefectProviderModel) tfprovider.ConfigureRequest {
 	t.Helper()
 
@@ -52,6 +82,12 @@ func newTestConfigureRequest(t *testing.T, model *provider.PrefectProviderModel)
 		"workspace_id":   tftypes.String,
 		"profile":        tftypes.String,
 		"profile_file":   tftypes.String,
+		"default_timeouts": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"read":   tftypes.String,
+			"update": tftypes.String,
+			"delete": tftypes.String,
+		}},
 	}
 
 	attrs := make(map[string]tftypes.Value)
@@ -66,6 +102,7 @@ func newTestConfigureRequest(t *testing.T, model *provider.PrefectProviderModel)
 		setUUIDAttr(attrs, "workspace_id", model.WorkspaceID)
 		setStringAttr(attrs, "profile", model.Profile)
 		setStringAttr(attrs, "profile_file", model.ProfileFile)
+		setDefaultTimeoutsAttr(attrs, attrTypes["default_timeouts"], model.DefaultTimeouts)
 	}
 
 	rawObject := tftypes.NewValue(tftypes.Object{AttributeTypes: attrTypes}, attrs)
@@ -210,3 +247,177 @@ func TestConfigure_CustomHeadersEmpty(t *testing.T) {
 		t.Fatalf("unexpected error: %v", resp.Diagnostics)
 	}
 }
+
+// TestConfigure_DefaultTimeouts tests that valid default timeouts can be configured.
+func TestConfigure_DefaultTimeouts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	prov := &provider.PrefectProvider{}
+	resp := &tfprovider.ConfigureResponse{}
+
+	config := &provider.PrefectProviderModel{
+		Endpoint:        types.StringValue("https://api.example.com"),
+		DefaultTimeouts: newDefaultTimeouts(t, "10m", "", "", "5m"),
+	}
+
+	req := newTestConfigureRequest(t, config)
+	prov.Configure(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+// TestConfigure_DefaultTimeoutsInvalid tests that invalid default timeouts return an error.
+func TestConfigure_DefaultTimeoutsInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"ten minutes", "0s", "-5m"} {
+		t.Run(value, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			prov := &provider.PrefectProvider{}
+			resp := &tfprovider.ConfigureResponse{}
+
+			config := &provider.PrefectProviderModel{
+				Endpoint:        types.StringValue("https://api.example.com"),
+				DefaultTimeouts: newDefaultTimeouts(t, value, "", "", ""),
+			}
+
+			req := newTestConfigureRequest(t, config)
+			prov.Configure(ctx, req, resp)
+
+			var found bool
+			for _, d := range resp.Diagnostics {
+				if d.Severity() == diag.SeverityError && strings.Contains(d.Summary(), "Invalid Default Timeout") {
+					found = true
+
+					break
+				}
+			}
+
+			if !found {
+				t.Fatalf("expected error for invalid default timeout %q", value)
+			}
+		})
+	}
+}
+
+func newDefaultTimeouts(t *testing.T, create, read, update, del string) types.Object {
+	t.Helper()
+
+	toString := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+
+		return types.StringValue(value)
+	}
+
+	value, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		},
+		map[string]attr.Value{
+			"create": toString(create),
+			"read":   toString(read),
+			"update": toString(update),
+			"delete": toString(del),
+		},
+	)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	return value
+}
+
+// TestConfigure_DeferredWhenWorkspaceIDUnknown tests that the provider defers
+// all resources and data sources when the workspace ID is not yet known.
+func TestConfigure_DeferredWhenWorkspaceIDUnknown(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	prov := &provider.PrefectProvider{}
+	resp := &tfprovider.ConfigureResponse{}
+
+	config := &provider.PrefectProviderModel{
+		Endpoint:    types.StringValue("https://api.example.com"),
+		AccountID:   customtypes.NewUUIDValue(uuid.New()),
+		WorkspaceID: customtypes.NewUUIDUnknown(),
+	}
+
+	req := newTestConfigureRequest(t, config)
+	req.ClientCapabilities.DeferralAllowed = true
+	prov.Configure(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if resp.Deferred == nil || resp.Deferred.Reason != tfprovider.DeferredReasonProviderConfigUnknown {
+		t.Fatalf("expected a deferred response with reason %q, got %v", tfprovider.DeferredReasonProviderConfigUnknown, resp.Deferred)
+	}
+
+	if resp.ResourceData != nil || resp.DataSourceData != nil || resp.ActionData != nil {
+		t.Fatalf("expected no client to be configured when deferring")
+	}
+}
+
+// TestConfigure_DeferredWhenEndpointUnknown tests that the provider defers
+// all resources and data sources when the endpoint is not yet known.
+func TestConfigure_DeferredWhenEndpointUnknown(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	prov := &provider.PrefectProvider{}
+	resp := &tfprovider.ConfigureResponse{}
+
+	config := &provider.PrefectProviderModel{
+		Endpoint: types.StringUnknown(),
+	}
+
+	req := newTestConfigureRequest(t, config)
+	req.ClientCapabilities.DeferralAllowed = true
+	prov.Configure(ctx, req, resp)
+
+	if resp.Deferred == nil {
+		t.Fatalf("expected a deferred response")
+	}
+}
+
+// TestConfigure_NotDeferredWithoutClientSupport tests that the provider
+// falls back to warnings when Terraform does not support deferred actions.
+func TestConfigure_NotDeferredWithoutClientSupport(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	prov := &provider.PrefectProvider{}
+	resp := &tfprovider.ConfigureResponse{}
+
+	config := &provider.PrefectProviderModel{
+		Endpoint:    types.StringValue("https://api.example.com"),
+		AccountID:   customtypes.NewUUIDValue(uuid.New()),
+		WorkspaceID: customtypes.NewUUIDUnknown(),
+	}
+
+	req := newTestConfigureRequest(t, config)
+	prov.Configure(ctx, req, resp)
+
+	if resp.Deferred != nil {
+		t.Fatalf("expected no deferred response when deferral is not allowed")
+	}
+
+	var found bool
+	for _, d := range resp.Diagnostics {
+		if d.Severity() == diag.SeverityWarning && strings.Contains(d.Summary(), "Unknown Prefect Workspace ID") {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		t.Fatalf("expected a warning for the unknown workspace ID")
+	}
+}
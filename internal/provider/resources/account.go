@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -40,6 +41,8 @@ type AccountResourceModel struct {
 	Link        types.String `tfsdk:"link"`
 	Settings    types.Object `tfsdk:"settings"`
 	DomainNames types.List   `tfsdk:"domain_names"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewAccountResource returns a new AccountResource.
@@ -71,7 +74,7 @@ func (r *AccountResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 // Schema defines the schema for the resource.
-func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AccountResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans("The resource `account` represents a Prefect Cloud account. "+
 			"It is used to manage the account's attributes, such as the name, handle, and location.\n"+
@@ -153,6 +156,9 @@ func (r *AccountResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -220,6 +226,15 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	accountID, err := uuid.Parse(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Account", err))
@@ -271,6 +286,15 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	accountID, err := uuid.Parse(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Account", err))
@@ -358,6 +382,15 @@ func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	accountID, err := uuid.Parse(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ParseUUIDErrorDiagnostic("Account", err))
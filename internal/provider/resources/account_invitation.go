@@ -0,0 +1,503 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&AccountInvitationResource{})
+	_ = resource.ResourceWithImportState(&AccountInvitationResource{})
+)
+
+// AccountInvitationResource contains state for the resource.
+type AccountInvitationResource struct {
+	client api.PrefectClient
+}
+
+// AccountInvitationResourceModel defines the Terraform resource model.
+type AccountInvitationResourceModel struct {
+	BaseModel
+
+	AccountID      customtypes.UUIDValue      `tfsdk:"account_id"`
+	Email          types.String               `tfsdk:"email"`
+	AccountRoleID  customtypes.UUIDValue      `tfsdk:"account_role_id"`
+	WorkspaceRoles types.Set                  `tfsdk:"workspace_roles"`
+	Status         types.String               `tfsdk:"status"`
+	Expires        customtypes.TimestampValue `tfsdk:"expires"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// WorkspaceInvitationModel defines a Workspace Role granted by an invitation.
+type WorkspaceInvitationModel struct {
+	WorkspaceID     customtypes.UUIDValue `tfsdk:"workspace_id"`
+	WorkspaceRoleID customtypes.UUIDValue `tfsdk:"workspace_role_id"`
+}
+
+var workspaceInvitationAttributeTypes = map[string]attr.Type{
+	"workspace_id":      customtypes.UUIDType{},
+	"workspace_role_id": customtypes.UUIDType{},
+}
+
+// NewAccountInvitationResource returns a new AccountInvitationResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAccountInvitationResource() resource.Resource {
+	return &AccountInvitationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *AccountInvitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_invitation"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *AccountInvitationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *AccountInvitationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans("The resource `account_invitation` represents an invitation for a user to join an account, by email. "+
+			"The invitation can also grant Workspace Roles, which are applied once the user accepts it."+
+			"\n\n"+
+			"The `status` attribute tracks whether the invitation is `pending`, `accepted` or `expired`. "+
+			"Once accepted, the membership is owned by the user: the invitation is no longer refreshed, "+
+			"changes to it have no effect, and destroying it does not remove the user from the account. "+
+			"To manage the new member's account role, import it into a `prefect_account_member` resource by email. "+
+			"An expired invitation can be sent again with `terraform apply -replace`."+
+			"\n\n"+
+			"For more information, see [manage users](https://docs.prefect.io/v3/manage/cloud/manage-users)",
+			helpers.AllCloudPlans...,
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account Invitation ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address of the user to invite",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfInvitationNotAccepted,
+						"Changing the email of an invitation that has not been accepted yet sends a new invitation.",
+						"Changing the email of an invitation that has not been accepted yet sends a new invitation.",
+					),
+				},
+			},
+			"account_role_id": schema.StringAttribute{
+				Required:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account Role ID (UUID) granted to the user when they accept the invitation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfInvitationNotAccepted,
+						"Changing the account role of an invitation that has not been accepted yet sends a new invitation.",
+						"Changing the account role of an invitation that has not been accepted yet sends a new invitation.",
+					),
+				},
+			},
+			"workspace_roles": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "Workspace Roles granted to the user when they accept the invitation",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workspace_id": schema.StringAttribute{
+							Required:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Workspace ID (UUID)",
+						},
+						"workspace_role_id": schema.StringAttribute{
+							Required:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Workspace Role ID (UUID) granted in the workspace",
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace, resp.Diagnostics = invitationNotAccepted(ctx, req.State)
+						},
+						"Changing the workspace roles of an invitation that has not been accepted yet sends a new invitation.",
+						"Changing the workspace roles of an invitation that has not been accepted yet sends a new invitation.",
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Status of the invitation: `%s`, `%s` or `%s`", api.AccountInvitationStatusPending, api.AccountInvitationStatusAccepted, api.AccountInvitationStatusExpired),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the invitation expires (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// requiresReplaceIfInvitationNotAccepted is a stringplanmodifier.RequiresReplaceIfFunc
+// that only replaces invitations that have not been accepted yet.
+func requiresReplaceIfInvitationNotAccepted(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace, resp.Diagnostics = invitationNotAccepted(ctx, req.State)
+}
+
+// invitationNotAccepted reports whether the invitation held in state has not
+// been accepted yet. Once accepted, the membership is managed by the
+// `prefect_account_member` resource, so the invitation must not be recreated.
+func invitationNotAccepted(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	var status types.String
+	diags := state.GetAttribute(ctx, path.Root("status"), &status)
+
+	return status.ValueString() != api.AccountInvitationStatusAccepted, diags
+}
+
+// accountInvitationStatus derives the status of an invitation.
+func accountInvitationStatus(invitation *api.AccountInvitation, now time.Time) string {
+	if invitation.AcceptedAt != nil {
+		return api.AccountInvitationStatusAccepted
+	}
+
+	if invitation.Expires != nil && now.After(*invitation.Expires) {
+		return api.AccountInvitationStatusExpired
+	}
+
+	return api.AccountInvitationStatusPending
+}
+
+// copyAccountInvitationToModel maps an API response to a model that is saved in Terraform state.
+// A model can be a Terraform Plan, State, or Config object.
+func copyAccountInvitationToModel(ctx context.Context, invitation *api.AccountInvitation, tfModel *AccountInvitationResourceModel) diag.Diagnostics {
+	tfModel.ID = customtypes.NewUUIDValue(invitation.ID)
+	tfModel.Created = customtypes.NewTimestampPointerValue(invitation.Created)
+	tfModel.Updated = customtypes.NewTimestampPointerValue(invitation.Updated)
+
+	// The API may normalize the email address, so the configured
+	// value is kept unless there is none, which is the case on import.
+	if tfModel.Email.IsNull() {
+		tfModel.Email = types.StringValue(invitation.Email)
+	}
+
+	tfModel.AccountRoleID = customtypes.NewUUIDValue(invitation.AccountRoleID)
+	tfModel.Status = types.StringValue(accountInvitationStatus(invitation, time.Now()))
+	tfModel.Expires = customtypes.NewTimestampPointerValue(invitation.Expires)
+
+	// The workspace roles are only read back from the API on import,
+	// as the API may not echo them back once the invitation is created.
+	if tfModel.WorkspaceRoles.IsNull() && len(invitation.WorkspaceInvitations) > 0 {
+		workspaceRoles := make([]WorkspaceInvitationModel, 0, len(invitation.WorkspaceInvitations))
+		for _, workspaceInvitation := range invitation.WorkspaceInvitations {
+			workspaceRoles = append(workspaceRoles, WorkspaceInvitationModel{
+				WorkspaceID:     customtypes.NewUUIDValue(workspaceInvitation.WorkspaceID),
+				WorkspaceRoleID: customtypes.NewUUIDValue(workspaceInvitation.WorkspaceRoleID),
+			})
+		}
+
+		set, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: workspaceInvitationAttributeTypes}, workspaceRoles)
+		if diags.HasError() {
+			return diags
+		}
+		tfModel.WorkspaceRoles = set
+	}
+
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AccountInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccountInvitationResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var workspaceRoles []WorkspaceInvitationModel
+	resp.Diagnostics.Append(plan.WorkspaceRoles.ElementsAs(ctx, &workspaceRoles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceInvitations := make([]api.WorkspaceInvitation, 0, len(workspaceRoles))
+	for _, workspaceRole := range workspaceRoles {
+		workspaceInvitations = append(workspaceInvitations, api.WorkspaceInvitation{
+			WorkspaceID:     workspaceRole.WorkspaceID.ValueUUID(),
+			WorkspaceRoleID: workspaceRole.WorkspaceRoleID.ValueUUID(),
+		})
+	}
+
+	client, err := r.client.AccountInvitations(plan.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Invitation", err))
+
+		return
+	}
+
+	invitation, err := client.Create(ctx, api.AccountInvitationCreate{
+		Email:                plan.Email.ValueString(),
+		AccountRoleID:        plan.AccountRoleID.ValueUUID(),
+		WorkspaceInvitations: workspaceInvitations,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Invitation", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountInvitationToModel(ctx, invitation, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccountInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccountInvitationResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Once accepted, the membership is managed by the `prefect_account_member`
+	// resource, so there is nothing left to refresh.
+	if state.Status.ValueString() == api.AccountInvitationStatusAccepted {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	client, err := r.client.AccountInvitations(state.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Invitation", err))
+
+		return
+	}
+
+	// Invitations are removed once they are accepted or revoked,
+	// so a missing invitation is expected and should not be retried.
+	invitation, err := client.Get(helpers.ContextWithoutNotFoundRetries(ctx), state.ID.ValueUUID())
+	if err != nil {
+		if !helpers.Is404Error(err) {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Invitation", "get", err))
+
+			return
+		}
+
+		accepted, diags := r.isAccountMember(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// If the invitation was revoked outside of Terraform, we can remove it
+		// from TF state so that the framework can queue up a new Create.
+		// https://discuss.hashicorp.com/t/recreate-a-resource-in-a-case-of-manual-deletion/66375/3
+		if !accepted {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		tflog.Info(ctx, "Account invitation was accepted", map[string]any{"email": state.Email.ValueString()})
+		state.Status = types.StringValue(api.AccountInvitationStatusAccepted)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountInvitationToModel(ctx, invitation, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// isAccountMember reports whether the invited user is a member of the account.
+func (r *AccountInvitationResource) isAccountMember(ctx context.Context, state AccountInvitationResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	client, err := r.client.AccountMemberships(state.AccountID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Account Membership", err))
+
+		return false, diags
+	}
+
+	memberships, err := client.List(ctx, []string{state.Email.ValueString()})
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Account Membership", "list", err))
+
+		return false, diags
+	}
+
+	return len(memberships) > 0, diags
+}
+
+// Update only persists changes that don't require a new invitation, such as
+// the timeouts, or any change to an invitation that was already accepted.
+func (r *AccountInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccountInvitationResourceModel
+	var state AccountInvitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() == api.AccountInvitationStatusAccepted &&
+		(!plan.Email.Equal(state.Email) || !plan.AccountRoleID.Equal(state.AccountRoleID) || !plan.WorkspaceRoles.Equal(state.WorkspaceRoles)) {
+		resp.Diagnostics.AddWarning(
+			"Account Invitation already accepted",
+			fmt.Sprintf("The invitation for %s was already accepted, so changes to it have no effect. "+
+				"Use the `prefect_account_member` resource to manage the member's account role.", state.Email.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the invitation if it has not been accepted yet.
+func (r *AccountInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccountInvitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Accepted invitations hand the membership over to the `prefect_account_member`
+	// resource, so removing the invitation must not remove the user from the account.
+	if state.Status.ValueString() == api.AccountInvitationStatusAccepted {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, err := r.client.AccountInvitations(state.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Invitation", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, state.ID.ValueUUID())
+	if err != nil && !helpers.Is404Error(err) {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Invitation", "delete", err))
+
+		return
+	}
+}
+
+// ImportState allows Terraform to start managing an Account Invitation resource.
+func (r *AccountInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
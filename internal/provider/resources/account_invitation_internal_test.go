@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+func TestAccountInvitationStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		invitation api.AccountInvitation
+		want       string
+	}{
+		{
+			name:       "no expiry",
+			invitation: api.AccountInvitation{},
+			want:       api.AccountInvitationStatusPending,
+		},
+		{
+			name:       "not expired yet",
+			invitation: api.AccountInvitation{Expires: &future},
+			want:       api.AccountInvitationStatusPending,
+		},
+		{
+			name:       "expired",
+			invitation: api.AccountInvitation{Expires: &past},
+			want:       api.AccountInvitationStatusExpired,
+		},
+		{
+			name:       "accepted before expiring",
+			invitation: api.AccountInvitation{Expires: &past, AcceptedAt: &past},
+			want:       api.AccountInvitationStatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, accountInvitationStatus(&tt.invitation, now))
+		})
+	}
+}
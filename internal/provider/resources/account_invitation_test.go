@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+func fixtureAccAccountInvitation(email, roleName string) string {
+	return fmt.Sprintf(`
+data "prefect_account_role" "role" {
+	name = "%s"
+}
+
+resource "prefect_account_invitation" "invitation" {
+	email = "%s"
+	account_role_id = data.prefect_account_role.role.id
+}`, roleName, email)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_account_invitation(t *testing.T) {
+	// Account invitations are not supported in OSS.
+	testutils.SkipTestsIfOSS(t)
+
+	resourceName := "prefect_account_invitation.invitation"
+	email := fmt.Sprintf("%s@example.com", testutils.NewRandomPrefixedString())
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of a pending invitation
+				Config: fixtureAccAccountInvitation(email, "Member"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "email", email),
+					testutils.ExpectKnownValue(resourceName, "status", "pending"),
+					testutils.ExpectKnownValueNotNull(resourceName, "expires"),
+					testutils.CompareValuePairs(resourceName, "account_role_id", "data.prefect_account_role.role", "id"),
+				},
+			},
+			{
+				// Check that changing the role of a pending invitation sends a new one
+				Config: fixtureAccAccountInvitation(email, "Admin"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "status", "pending"),
+					testutils.CompareValuePairs(resourceName, "account_role_id", "data.prefect_account_role.role", "id"),
+				},
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -41,6 +42,8 @@ type AccountMemberResourceModel struct {
 	AccountRoleName types.String          `tfsdk:"account_role_name"`
 
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewAccountMemberResource returns a new AccountMemberResource.
@@ -72,14 +75,15 @@ func (r *AccountMemberResource) Configure(_ context.Context, req resource.Config
 }
 
 // Schema defines the schema for the resource.
-func (r *AccountMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AccountMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans("The resource `account_member` represents a member of an account. "+
 			"It is used to manage the member's account role, such as Member or Admin."+
 			"\n"+
 			"This resource cannot be created by Terraform because memberships are created "+
 			"when a user accepts an invitation to join an account. Because of this limitation, "+
-			"first import the resource and then the attributes can be updated as needed."+
+			"first import the resource and then the attributes can be updated as needed. "+
+			"To invite new users, see the `prefect_account_invitation` resource."+
 			"\n"+
 			"For more information, see [manage users](https://docs.prefect.io/v3/manage/cloud/manage-users)",
 			helpers.AllCloudPlans...,
@@ -141,6 +145,9 @@ func (r *AccountMemberResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -172,6 +179,15 @@ func (r *AccountMemberResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.AccountMemberships(state.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
@@ -215,6 +231,15 @@ func (r *AccountMemberResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.AccountMemberships(plan.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
@@ -274,6 +299,15 @@ func (r *AccountMemberResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.AccountMemberships(state.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account", err))
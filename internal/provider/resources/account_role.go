@@ -0,0 +1,528 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&AccountRoleResource{})
+	_ = resource.ResourceWithImportState(&AccountRoleResource{})
+	_ = resource.ResourceWithModifyPlan(&AccountRoleResource{})
+)
+
+// ownerAccountRoleName is the name of the system Account Role that holds
+// every account permission. Its permissions are used as the catalogue
+// of valid permission names.
+const ownerAccountRoleName = "Owner"
+
+// AccountRoleResource contains state for the resource.
+type AccountRoleResource struct {
+	client api.PrefectClient
+}
+
+// AccountRoleResourceModel defines the Terraform resource model.
+type AccountRoleResourceModel struct {
+	BaseModel
+
+	Name        types.String          `tfsdk:"name"`
+	Description types.String          `tfsdk:"description"`
+	Permissions types.Set             `tfsdk:"permissions"`
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewAccountRoleResource returns a new AccountRoleResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewAccountRoleResource() resource.Resource {
+	return &AccountRoleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *AccountRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_role"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *AccountRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *AccountRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(
+			"The resource `account_role` represents a custom Prefect Cloud Account Role. "+
+				"Account Roles hold a set of account-level permissions, and can be assigned to "+
+				"Users and Service Accounts to grant access to the Account.\n"+
+				"\n"+
+				"Valid permission names are those held by the system `Owner` role, "+
+				"which can be listed with the `prefect_account_role` data source. "+
+				"Unknown permissions are rejected during plan.\n"+
+				"\n"+
+				"For more information, see [manage account roles](https://docs.prefect.io/v3/manage/cloud/manage-users/manage-roles#manage-account-roles).",
+			helpers.PlanEnterprise,
+		),
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account Role ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was created (RFC3339)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.TimestampType{},
+				Description: "Timestamp of when the resource was updated (RFC3339)",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the Account Role",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Description of the Account Role",
+				Default:     stringdefault.StaticString(""),
+			},
+			"permissions": schema.SetAttribute{
+				Required:    true,
+				Description: "Set of account permissions granted by the Account Role. The permissions of the system `Owner` role list every valid permission.",
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				CustomType:  customtypes.UUIDType{},
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// copyAccountRoleToModel maps an API response to a model that is saved in Terraform state.
+// A model can be a Terraform Plan, State, or Config object.
+func copyAccountRoleToModel(ctx context.Context, role *api.AccountRole, tfModel *AccountRoleResourceModel) diag.Diagnostics {
+	tfModel.ID = customtypes.NewUUIDValue(role.ID)
+	tfModel.Created = customtypes.NewTimestampPointerValue(role.Created)
+	tfModel.Updated = customtypes.NewTimestampPointerValue(role.Updated)
+
+	tfModel.Name = types.StringValue(role.Name)
+	tfModel.Description = types.StringValue("")
+	if role.Description != nil {
+		tfModel.Description = types.StringValue(*role.Description)
+	}
+
+	if role.AccountID != nil {
+		tfModel.AccountID = customtypes.NewUUIDValue(*role.AccountID)
+	}
+
+	// As with Workspace Role scopes, the permissions held in state are the
+	// user-defined ones, so that any permissions implied by them on the
+	// Prefect Cloud side don't cause a perpetual diff. They are only read
+	// back from the API when state holds none, which is the case on import.
+	if tfModel.Permissions.IsNull() || tfModel.Permissions.IsUnknown() {
+		permissions, diags := types.SetValueFrom(ctx, types.StringType, role.Permissions)
+		if diags.HasError() {
+			return diags
+		}
+		tfModel.Permissions = permissions
+	}
+
+	return nil
+}
+
+// ModifyPlan validates the configured permissions against the permissions
+// held by the system Owner role.
+func (r *AccountRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy, or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan AccountRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Permissions.IsUnknown() || plan.AccountID.IsUnknown() {
+		return
+	}
+
+	for _, permission := range plan.Permissions.Elements() {
+		if permission.IsUnknown() {
+			return
+		}
+	}
+
+	// Only look up the permission catalogue when the permissions have changed,
+	// so that plans without changes don't issue additional API requests.
+	if !req.State.Raw.IsNull() {
+		var state AccountRoleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Permissions.Equal(state.Permissions) {
+			return
+		}
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validatePermissions(ctx, plan.AccountID.ValueUUID(), permissions)...)
+}
+
+// validatePermissions returns an error diagnostic listing the permissions that
+// are not held by the system Owner role. If the Owner role can't be retrieved,
+// validation is left to the API.
+func (r *AccountRoleResource) validatePermissions(ctx context.Context, accountID uuid.UUID, permissions []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := r.client.AccountRoles(accountID)
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return diags
+	}
+
+	roles, err := client.List(ctx, []string{ownerAccountRoleName})
+	if err != nil || len(roles) != 1 || len(roles[0].Permissions) == 0 {
+		tflog.Debug(ctx, "Skipping Account Role permission validation, unable to read the Owner role", map[string]any{
+			"error": err,
+		})
+
+		return diags
+	}
+
+	unknownPermissions := invalidAccountPermissions(permissions, roles[0].Permissions)
+	if len(unknownPermissions) > 0 {
+		diags.AddAttributeError(
+			path.Root("permissions"),
+			"Unknown Account Role permissions",
+			fmt.Sprintf(
+				"The following permissions are not valid account permissions: %s. Valid permissions are: %s.",
+				strings.Join(unknownPermissions, ", "),
+				strings.Join(roles[0].Permissions, ", "),
+			),
+		)
+	}
+
+	return diags
+}
+
+// invalidAccountPermissions returns the sorted permissions that are
+// not part of the available permissions.
+func invalidAccountPermissions(permissions, availablePermissions []string) []string {
+	invalid := []string{}
+	for _, permission := range permissions {
+		if !slices.Contains(availablePermissions, permission) {
+			invalid = append(invalid, permission)
+		}
+	}
+
+	slices.Sort(invalid)
+
+	return invalid
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *AccountRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccountRoleResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var permissions []string
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountRoles(plan.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	role, err := client.Create(ctx, api.AccountRoleUpsert{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Permissions: permissions,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "create", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountRoleToModel(ctx, role, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AccountRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccountRoleResourceModel
+
+	// Populate the model from state and emit diagnostics on error
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	if state.ID.IsNull() && state.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Both ID and Name are unset",
+			"This is a bug in the Terraform provider. Please report it to the maintainers.",
+		)
+
+		return
+	}
+
+	client, err := r.client.AccountRoles(state.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	// An Account Role can be read by either ID or Name.
+	// If both are set, we prefer the ID
+	var role *api.AccountRole
+	var operation string
+	if !state.ID.IsNull() {
+		operation = "get"
+		role, err = client.Get(ctx, state.ID.ValueUUID())
+	} else {
+		var roles []*api.AccountRole
+		operation = "list"
+		roles, err = client.List(ctx, []string{state.Name.ValueString()})
+
+		// The error from the API call should take precedence
+		// followed by this custom error if a specific role is not returned
+		if err == nil && len(roles) != 1 {
+			err = fmt.Errorf("an Account Role with the name=%s could not be found", state.Name.ValueString())
+		}
+
+		if len(roles) == 1 {
+			role = roles[0]
+		}
+	}
+
+	if err != nil {
+		// If the remote object does not exist, we can remove it from TF state
+		// so that the framework can queue up a new Create.
+		// https://discuss.hashicorp.com/t/recreate-a-resource-in-a-case-of-manual-deletion/66375/3
+		if helpers.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", operation, err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountRoleToModel(ctx, role, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *AccountRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AccountRoleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var permissions []string
+	resp.Diagnostics.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.AccountRoles(plan.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	roleID := plan.ID.ValueUUID()
+
+	err = client.Update(ctx, roleID, api.AccountRoleUpsert{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Permissions: permissions,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "update", err))
+
+		return
+	}
+
+	role, err := client.Get(ctx, roleID)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "get", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(copyAccountRoleToModel(ctx, role, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AccountRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccountRoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, err := r.client.AccountRoles(state.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Account Role", err))
+
+		return
+	}
+
+	err = client.Delete(ctx, state.ID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Account Role", "delete", err))
+
+		return
+	}
+}
+
+// ImportState allows Terraform to start managing an Account Role resource.
+func (r *AccountRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if name, ok := strings.CutPrefix(req.ID, "name/"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	} else {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	}
+}
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidAccountPermissions(t *testing.T) {
+	t.Parallel()
+
+	available := []string{"see:workspaces", "manage:workspaces", "see:members"}
+
+	tests := []struct {
+		name        string
+		permissions []string
+		want        []string
+	}{
+		{
+			name:        "all permissions valid",
+			permissions: []string{"see:members", "see:workspaces"},
+			want:        []string{},
+		},
+		{
+			name:        "invalid permissions are sorted",
+			permissions: []string{"see:workspaces", "see:wrokspaces", "manage:billing"},
+			want:        []string{"manage:billing", "see:wrokspaces"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, invalidAccountPermissions(tt.permissions, available))
+		})
+	}
+}
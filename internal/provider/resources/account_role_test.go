@@ -0,0 +1,137 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+// The permissions of the default Member role are used to build the custom
+// roles, so that the tests don't depend on the exact permission names.
+func fixtureAccAccountRoleResource(name, description, permissions string) string {
+	return fmt.Sprintf(`
+data "prefect_account_role" "member" {
+	name = "Member"
+}
+
+resource "prefect_account_role" "role" {
+	name = "%s"
+	description = "%s"
+	permissions = %s
+}`, name, description, permissions)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_account_role(t *testing.T) {
+	// Account roles are not supported in OSS.
+	testutils.SkipTestsIfOSS(t)
+
+	resourceName := "prefect_account_role.role"
+	randomName := testutils.NewRandomPrefixedString()
+
+	// We use this variable to store the fetched resource from the API
+	// and it will be shared between TestSteps via a pointer.
+	var accountRole api.AccountRole
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation + existence of the account role resource
+				Config: fixtureAccAccountRoleResource(randomName, "first description", "data.prefect_account_role.member.permissions"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRoleExists(resourceName, &accountRole),
+					testAccCheckAccountRoleValues(&accountRole, randomName, "first description"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "name", randomName),
+					testutils.ExpectKnownValue(resourceName, "description", "first description"),
+					testutils.ExpectKnownValueNotNull(resourceName, "account_id"),
+				},
+			},
+			{
+				// Check updates for the account role resource
+				Config: fixtureAccAccountRoleResource(randomName, "second description", "slice(sort(data.prefect_account_role.member.permissions), 0, 1)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRoleExists(resourceName, &accountRole),
+					testAccCheckAccountRoleValues(&accountRole, randomName, "second description"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "description", "second description"),
+				},
+			},
+			{
+				// Check that unknown permissions are rejected during plan
+				Config:      fixtureAccAccountRoleResource(randomName, "second description", `["not:a_permission"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`not valid account permissions: not:a_permission`),
+			},
+			// Import State checks - import by ID (default)
+			{
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+			},
+			// Import State checks - import by name
+			{
+				ImportState:             true,
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("name/%s", randomName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"id"},
+			},
+		},
+	})
+}
+
+func testAccCheckAccountRoleExists(roleResourceName string, role *api.AccountRole) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		accountRoleID, err := testutils.GetResourceIDFromState(state, roleResourceName)
+		if err != nil {
+			return fmt.Errorf("error fetching account role ID: %w", err)
+		}
+
+		// Create a new client, and use the default configurations from the environment
+		c, _ := testutils.NewTestClient()
+		accountRolesClient, _ := c.AccountRoles(uuid.Nil)
+
+		fetchedAccountRole, err := accountRolesClient.Get(context.Background(), accountRoleID)
+		if err != nil {
+			return fmt.Errorf("Error fetching Account Role: %w", err)
+		}
+		if fetchedAccountRole == nil {
+			return fmt.Errorf("Account Role not found for ID: %s", accountRoleID)
+		}
+
+		*role = *fetchedAccountRole
+
+		return nil
+	}
+}
+
+func testAccCheckAccountRoleValues(fetchedRole *api.AccountRole, name, description string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if fetchedRole.Name != name {
+			return fmt.Errorf("Expected Account Role name %s, got: %s", name, fetchedRole.Name)
+		}
+
+		if fetchedRole.Description == nil || *fetchedRole.Description != description {
+			return fmt.Errorf("Expected Account Role description %s, got: %v", description, fetchedRole.Description)
+		}
+
+		if fetchedRole.IsSystemRole {
+			return fmt.Errorf("Expected Account Role %s to be a custom role", name)
+		}
+
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 )
@@ -21,6 +22,8 @@ type AutomationResourceModel struct {
 	Actions          []ActionModel `tfsdk:"actions"`
 	ActionsOnTrigger []ActionModel `tfsdk:"actions_on_trigger"`
 	ActionsOnResolve []ActionModel `tfsdk:"actions_on_resolve"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ResourceTriggerModel comprises the event and metric trigger models.
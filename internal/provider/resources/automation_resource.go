@@ -65,7 +65,7 @@ func (r *AutomationResource) Configure(_ context.Context, req resource.Configure
 }
 
 // Schema defines the schema for the resource.
-func (r *AutomationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AutomationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(`
 The resource 'automations' represents a Prefect Automation.
@@ -76,6 +76,9 @@ For more information, see [automate overview](https://docs.prefect.io/v3/automat
 		),
 		Version:    0,
 		Attributes: AutomationSchema(),
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -89,6 +92,15 @@ func (r *AutomationResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	automationClient, err := r.client.Automations(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
@@ -139,6 +151,15 @@ func (r *AutomationResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.Automations(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
@@ -189,6 +210,15 @@ func (r *AutomationResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	automationClient, err := r.client.Automations(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
@@ -245,6 +275,15 @@ func (r *AutomationResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	automationClient, err := r.client.Automations(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Automation", err))
@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
-	"github.com/avast/retry-go/v4"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,11 +21,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
 )
 
+var (
+	_ = resource.ResourceWithModifyPlan(&BlockResource{})
+)
+
 type BlockResource struct {
 	client api.PrefectClient
 }
@@ -38,6 +46,9 @@ type BlockResourceModel struct {
 	Data          jsontypes.Normalized `tfsdk:"data"`
 	DataWO        jsontypes.Normalized `tfsdk:"data_wo"`
 	DataWOVersion types.Int32          `tfsdk:"data_wo_version"`
+	References    types.Map            `tfsdk:"references"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewBlockResource returns a new BlockResource.
@@ -68,7 +79,7 @@ func (r *BlockResource) Configure(_ context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
-func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BlockResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `block` allows creating and managing [Prefect Blocks](https://docs.prefect.io/latest/concepts/blocks/), "+
@@ -83,7 +94,10 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				"\n"+
 				"Use `prefect block type inspect <slug>` to view the data schema for a given Block type. Use this to construct the `data` attribute value (as JSON string)."+
 				"\n"+
-				"*NOTE:* drift in the `.data` attribute is detected for Blocks managed in Terraform, so out-of-band changes (e.g. edits made in the Prefect UI) will surface on the next plan. Two carve-outs apply: Blocks whose `data` contains a `$ref` expression do not have drift detected (see the `$ref` section below), and Blocks managed via the write-only `data_wo` attribute do not have drift detected (bump `data_wo_version` to force an update).",
+				"*NOTE:* drift in the `.data` attribute is detected for Blocks managed in Terraform, so out-of-band changes (e.g. edits made in the Prefect UI) will surface on the next plan. Two carve-outs apply: Blocks whose `data` contains a `$ref` expression do not have drift detected (see the `$ref` section below), and Blocks managed via the write-only `data_wo` attribute do not have drift detected (bump `data_wo_version` to force an update)."+
+				"\n"+
+				"Use the `references` attribute to reference other Block documents, for example the `aws-credentials` Block used by an `s3-bucket` Block, instead of duplicating their data. "+
+				"Referenced fields are left out of `data`, and drift in the referenced Block IDs is detected.",
 			helpers.AllPlans...,
 		),
 		Version: 0,
@@ -127,7 +141,7 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Sensitive:   true,
 				CustomType:  jsontypes.NormalizedType{},
-				Description: "The user-inputted Block payload, as a JSON string. Use `jsonencode` on the provided value to satisfy the underlying JSON type. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type.",
+				Description: "The user-inputted Block payload, as a JSON string. Use `jsonencode` on the provided value to satisfy the underlying JSON type. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type. The payload is validated against the latest Block schema for the `type_slug` during plan, and a warning is shown for any secret fields, which are better managed with `data_wo`.",
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("data_wo"),
@@ -139,7 +153,7 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Sensitive:   true,
 				WriteOnly:   true,
 				CustomType:  jsontypes.NormalizedType{},
-				Description: "The user-inputted Block payload, as a JSON string. Use `jsonencode` on the provided value to satisfy the underlying JSON type. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type.",
+				Description: "The user-inputted Block payload, as a JSON string. Use `jsonencode` on the provided value to satisfy the underlying JSON type. The value's schema will depend on the selected `type` slug. Use `prefect block type inspect <slug>` to view the data schema for a given Block type. The payload is validated against the latest Block schema for the `type_slug` during plan.",
 				Validators: []validator.String{
 					stringvalidator.ConflictsWith(
 						path.MatchRoot("data"),
@@ -153,6 +167,11 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					int32validator.AlsoRequires(path.MatchRoot("data_wo")),
 				},
 			},
+			"references": schema.MapAttribute{
+				Optional:    true,
+				ElementType: customtypes.UUIDType{},
+				Description: "Block documents referenced by the fields of this Block, as a map of field name to Block ID (UUID). The fields must be declared as Block references (`block_schema_references`) by the Block schema, and must not be set in `data` or `data_wo`.",
+			},
 			"account_id": schema.StringAttribute{
 				Optional:    true,
 				CustomType:  customtypes.UUIDType{},
@@ -170,6 +189,9 @@ func (r *BlockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -202,39 +224,269 @@ func (r *BlockResource) getBlockSchemas(ctx context.Context, plan BlockResourceM
 
 // getLatestBlockSchema fetches the latest block schema for a given block type slug.
 // If no block schemas are returned, the retrieval is retried because Prefect creates
-// them asynchronously after the creation of a workspace.
+// them asynchronously after the creation of a workspace. Retries continue until the
+// operation's timeout if one is configured.
 //
 //nolint:ireturn // required by Terraform API
 func (r *BlockResource) getLatestBlockSchema(ctx context.Context, plan BlockResourceModel) (*api.BlockSchema, diag.Diagnostic) {
-	var blockSchemas []*api.BlockSchema
-	var latestBlockSchema *api.BlockSchema
-	var diags diag.Diagnostic
-
-	err := retry.Do(func() error {
-		blockSchemas, diags = r.getBlockSchemas(ctx, plan)
-		if diags != nil {
-			return fmt.Errorf("unable to get block schemas: %s", diags.Detail())
+	blockSchemas, err := helpers.WaitForResourceStabilization(
+		ctx,
+		func(ctx context.Context) ([]*api.BlockSchema, error) {
+			blockSchemas, diags := r.getBlockSchemas(ctx, plan)
+			if diags != nil {
+				return nil, fmt.Errorf("unable to get block schemas: %s", diags.Detail())
+			}
+
+			return blockSchemas, nil
+		},
+		func(blockSchemas []*api.BlockSchema) error {
+			if len(blockSchemas) == 0 {
+				return fmt.Errorf("no block schemas found")
+			}
+
+			return nil
+		},
+	)
+
+	if err != nil || len(blockSchemas) == 0 {
+		return nil, diag.NewErrorDiagnostic(
+			"No block schemas found",
+			fmt.Sprintf("No block schemas found for %s block type slug", plan.TypeSlug.ValueString()),
+		)
+	}
+
+	return blockSchemas[0], nil
+}
+
+// ModifyPlan validates the Block data against the latest Block schema for the
+// `type_slug`, so that invalid payloads are reported during plan rather than
+// failing (or being silently accepted) at apply time.
+//
+// Validation only runs when the data is known and has changed, so that
+// refresh-only plans don't issue additional API requests.
+func (r *BlockResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan BlockResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attributes are only available in the config.
+	var config BlockResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state BlockResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Data.Equal(state.Data) && plan.DataWOVersion.Equal(state.DataWOVersion) && plan.References.Equal(state.References) {
+			return
+		}
+	}
+
+	// The schema can't be looked up until the Block type and its location are known,
+	// for example when the workspace is created in the same apply.
+	if plan.TypeSlug.IsUnknown() || plan.AccountID.IsUnknown() || plan.WorkspaceID.IsUnknown() || plan.References.IsUnknown() {
+		return
+	}
+
+	attribute := "data"
+	dataValue := plan.Data
+	if dataValue.IsNull() {
+		attribute = "data_wo"
+		dataValue = config.DataWO
+	}
+
+	if dataValue.IsUnknown() {
+		return
+	}
+
+	data, diags := helpers.UnmarshalOptional(dataValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	references := map[string]customtypes.UUIDValue{}
+	if !plan.References.IsNull() {
+		resp.Diagnostics.Append(plan.References.ElementsAs(ctx, &references, false)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+	}
 
-		if len(blockSchemas) == 0 {
-			return fmt.Errorf("no block schemas found")
+	if data == nil && len(references) == 0 {
+		return
+	}
+
+	referenceFields := slices.Sorted(maps.Keys(references))
+	for _, field := range referenceFields {
+		if _, ok := data[field]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("references").AtMapKey(field),
+				"Conflicting Block reference",
+				fmt.Sprintf("Field `%s` is set in both `%s` and `references`.", field, attribute),
+			)
 		}
+	}
 
-		latestBlockSchema = blockSchemas[0]
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		return nil
-	})
+	// The Block type or schema may not exist yet, for example when they are
+	// created by `prefect_block_type` and `prefect_block_schema` in the same apply.
+	// In that case, we leave validation to the API.
+	blockSchemas, schemaDiag := r.getBlockSchemas(ctx, plan)
+	if schemaDiag != nil || len(blockSchemas) == 0 {
+		tflog.Debug(ctx, "Skipping Block data validation, no Block schema found", map[string]any{
+			"type_slug": plan.TypeSlug.ValueString(),
+		})
+
+		return
+	}
+
+	latestBlockSchema := blockSchemas[0]
+
+	// References are validated as part of the data, so that they satisfy required fields.
+	// The IDs of Blocks created in the same apply are not known yet, and are checked by the API.
+	merged := maps.Clone(data)
+	if merged == nil {
+		merged = map[string]any{}
+	}
+
+	for field, id := range references {
+		referenceID := uuid.Nil.String()
+		if !id.IsUnknown() && !id.IsNull() {
+			referenceID = id.ValueString()
+		}
+
+		merged[field] = blockReference(referenceID)
+	}
+
+	resp.Diagnostics.Append(validateBlockData(latestBlockSchema.Fields, merged, attribute)...)
+
+	referencedSlugs, diags := r.getReferencedBlockTypeSlugs(ctx, plan, references)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBlockReferences(latestBlockSchema.Fields, referenceFields, referencedSlugs)...)
 
+	if attribute == "data" && data != nil {
+		if secretFields := blockSecretFields(latestBlockSchema.Fields, data); len(secretFields) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("data"),
+				"Block data contains secret fields",
+				fmt.Sprintf("The following fields are marked as secret by the `%s` Block schema, and will be stored in the Terraform state and plan: %s. "+
+					"Consider moving the Block data to the write-only `data_wo` attribute (along with `data_wo_version`) so that secrets are never persisted.",
+					plan.TypeSlug.ValueString(), "`"+strings.Join(secretFields, "`, `")+"`"),
+			)
+		}
+	}
+}
+
+// getReferencedBlockTypeSlugs returns the Block type slug of each referenced Block
+// document whose ID is known, keyed by field. Referenced Blocks that cannot be read
+// are left out, so that the API remains the source of truth, unless they do not exist.
+func (r *BlockResource) getReferencedBlockTypeSlugs(ctx context.Context, plan BlockResourceModel, references map[string]customtypes.UUIDValue) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	slugs := make(map[string]string, len(references))
+	if len(references) == 0 {
+		return slugs, diags
+	}
+
+	client, err := r.client.BlockDocuments(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
-		diags = diag.NewErrorDiagnostic(
-			"No block schemas found",
-			fmt.Sprintf("No block schemas found for %s block type slug", plan.TypeSlug.ValueString()),
-		)
+		diags.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
 
+		return slugs, diags
+	}
+
+	for field, id := range references {
+		if id.IsUnknown() || id.IsNull() {
+			continue
+		}
+
+		referenced, err := client.Get(ctx, id.ValueUUID())
+		if err != nil {
+			if helpers.Is404Error(err) {
+				diags.AddAttributeError(
+					path.Root("references").AtMapKey(field),
+					"Block reference not found",
+					fmt.Sprintf("Field `%s` references Block %s, which does not exist.", field, id.ValueString()),
+				)
+
+				continue
+			}
+
+			tflog.Debug(ctx, "Skipping Block reference validation, referenced Block could not be read", map[string]any{
+				"field": field,
+				"error": err.Error(),
+			})
+
+			continue
+		}
+
+		slugs[field] = referenced.BlockType.Slug
+	}
+
+	return slugs, diags
+}
+
+// blockReference returns the `$ref` expression pointing to the given Block document ID.
+func blockReference(id string) map[string]any {
+	return map[string]any{"$ref": map[string]any{"block_document_id": id}}
+}
+
+// blockDataWithReferences returns a copy of the Block data, with a `$ref` expression
+// for each field of the `references` attribute.
+func blockDataWithReferences(ctx context.Context, data map[string]any, references types.Map) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if references.IsNull() || references.IsUnknown() {
+		return data, diags
+	}
+
+	ids := map[string]customtypes.UUIDValue{}
+	diags.Append(references.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
 		return nil, diags
 	}
 
-	return latestBlockSchema, nil
+	payload := maps.Clone(data)
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	for field, id := range ids {
+		if _, ok := payload[field]; ok {
+			diags.AddAttributeError(
+				path.Root("references").AtMapKey(field),
+				"Conflicting Block reference",
+				fmt.Sprintf("Field `%s` is set in both the Block data and `references`.", field),
+			)
+
+			return nil, diags
+		}
+
+		payload[field] = blockReference(id.ValueString())
+	}
+
+	return payload, diags
 }
 
 // copyBlockToModel maps an API response to a model that is saved in Terraform state.
@@ -246,7 +498,11 @@ func (r *BlockResource) getLatestBlockSchema(ctx context.Context, plan BlockReso
 // server-side and would return the resolved form, causing inconsistent-result
 // errors) or when the user supplied data via the write-only data_wo attribute
 // (state must remain null to honor the write-only contract).
-func copyBlockToModel(block *api.BlockDocument, tfModel *BlockResourceModel, persistData bool) diag.Diagnostics {
+//
+// Fields that reference other Block documents are hydrated by the API with the
+// referenced data, so they are left out of tfModel.Data. When tfModel.References
+// is set, it is refreshed from the references returned by the API.
+func copyBlockToModel(ctx context.Context, block *api.BlockDocument, tfModel *BlockResourceModel, persistData bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	tfModel.ID = customtypes.NewUUIDValue(block.ID)
@@ -256,7 +512,12 @@ func copyBlockToModel(block *api.BlockDocument, tfModel *BlockResourceModel, per
 	tfModel.TypeSlug = types.StringValue(block.BlockType.Slug)
 
 	if persistData {
-		byteSlice, err := json.Marshal(block.Data)
+		data := maps.Clone(block.Data)
+		for field := range block.BlockDocumentReferences {
+			delete(data, field)
+		}
+
+		byteSlice, err := json.Marshal(data)
 		if err != nil {
 			diags.Append(helpers.SerializeDataErrorDiagnostic("data", "Block Data", err))
 
@@ -265,6 +526,21 @@ func copyBlockToModel(block *api.BlockDocument, tfModel *BlockResourceModel, per
 		tfModel.Data = jsontypes.NewNormalizedValue(string(byteSlice))
 	}
 
+	if !tfModel.References.IsNull() {
+		references := make(map[string]customtypes.UUIDValue, len(block.BlockDocumentReferences))
+		for field, reference := range block.BlockDocumentReferences {
+			references[field] = customtypes.NewUUIDValue(reference.BlockDocument.ID)
+		}
+
+		referencesMap, mapDiags := types.MapValueFrom(ctx, customtypes.UUIDType{}, references)
+		diags.Append(mapDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		tfModel.References = referencesMap
+	}
+
 	return diags
 }
 
@@ -305,6 +581,15 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Also get the config to evaluate write-only attributes that
 	// are only available in the config, not the plan.
 	var config BlockResourceModel
@@ -348,9 +633,15 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 		data = dataWO
 	}
 
+	payload, diags := blockDataWithReferences(ctx, data, plan.References)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createdBlockDocument, err := blockDocumentClient.Create(ctx, api.BlockDocumentCreate{
 		Name:          plan.Name.ValueString(),
-		Data:          data,
+		Data:          payload,
 		BlockSchemaID: latestBlockSchema.ID,
 		BlockTypeID:   latestBlockSchema.BlockTypeID,
 	})
@@ -368,9 +659,9 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 	//   - the user supplied no data at all.
 	persistData := len(dataWO) == 0 && len(data) > 0 && !containsRef(data)
 
-	// The POST response does not include unmasked secrets, so fetch the block
-	// again before copying its Data into state.
-	if persistData {
+	// The POST response does not include unmasked secrets nor references,
+	// so fetch the block again before copying its Data into state.
+	if persistData || !plan.References.IsNull() {
 		blockID := createdBlockDocument.ID
 		fetched, err := blockDocumentClient.Get(ctx, blockID)
 		if err != nil {
@@ -381,7 +672,7 @@ func (r *BlockResource) Create(ctx context.Context, req resource.CreateRequest,
 		createdBlockDocument = fetched
 	}
 
-	diags = copyBlockToModel(createdBlockDocument, &plan, persistData)
+	diags = copyBlockToModel(ctx, createdBlockDocument, &plan, persistData)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -404,6 +695,15 @@ func (r *BlockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	if state.ID.IsNull() {
 		resp.Diagnostics.AddError(
 			"ID is unset",
@@ -454,7 +754,7 @@ func (r *BlockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	persistData := !state.Data.IsNull() && !containsRef(priorData)
 
-	diags = copyBlockToModel(block, &state, persistData)
+	diags = copyBlockToModel(ctx, block, &state, persistData)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -475,6 +775,15 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Also get the config to evaluate write-only attributes that
 	// are only available in the config, not the plan.
 	var config BlockResourceModel
@@ -517,8 +826,9 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// If the data_wo_version is different, we need to update the data payload
-	// using the content of the data_wo attribute.
-	if !plan.DataWOVersion.Equal(state.DataWOVersion) {
+	// using the content of the data_wo attribute. As the data is replaced rather
+	// than merged, the data_wo attribute is also sent when only the references change.
+	if !plan.DataWOVersion.Equal(state.DataWOVersion) || (plan.Data.IsNull() && !plan.References.Equal(state.References)) {
 		data, diags = helpers.UnmarshalOptional(config.DataWO)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
@@ -526,9 +836,15 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	payload, diags := blockDataWithReferences(ctx, data, plan.References)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err = blockDocumentClient.Update(ctx, blockID, api.BlockDocumentUpdate{
 		BlockSchemaID: latestBlockSchema.ID,
-		Data:          data,
+		Data:          payload,
 
 		// NOTE: setting this to `false` will replace the contents of `.data`
 		// We want to do this on Update() - if we don't, removing top-level keys
@@ -556,7 +872,7 @@ func (r *BlockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		!plan.Data.IsNull() &&
 		!containsRef(data)
 
-	diags = copyBlockToModel(block, &plan, persistData)
+	diags = copyBlockToModel(ctx, block, &plan, persistData)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -578,6 +894,15 @@ func (r *BlockResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	blockDocumentClient, err := r.client.BlockDocuments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
@@ -599,6 +924,17 @@ func (r *BlockResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// Wait until the block is gone, so a block with the same name
+	// can be created in the same apply.
+	err = helpers.WaitForResourceDeletion(ctx, func(ctx context.Context) (*api.BlockDocument, error) {
+		return blockDocumentClient.Get(ctx, blockDocumentID)
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Document", "delete", err))
+
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,6 +29,8 @@ type BlockAccessResourceModel struct {
 	ViewTeamIDs    types.List            `tfsdk:"view_team_ids"`
 	AccountID      customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID    customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewBlockAccessResource returns a new BlockAccessResource.
@@ -58,7 +61,7 @@ func (r *BlockAccessResource) Configure(_ context.Context, req resource.Configur
 	r.client = client
 }
 
-func (r *BlockAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BlockAccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
@@ -124,6 +127,9 @@ Leave fields empty to use the default access controls
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 func (r *BlockAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -134,6 +140,15 @@ func (r *BlockAccessResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.BlockDocuments(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
@@ -192,6 +207,15 @@ func (r *BlockAccessResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.BlockDocuments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
@@ -233,6 +257,15 @@ func (r *BlockAccessResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.BlockDocuments(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
@@ -290,6 +323,15 @@ func (r *BlockAccessResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.BlockDocuments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Document", err))
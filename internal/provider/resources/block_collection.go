@@ -0,0 +1,710 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&BlockCollectionResource{})
+	_ = resource.ResourceWithModifyPlan(&BlockCollectionResource{})
+)
+
+// BlockCollectionResource contains state for the resource.
+type BlockCollectionResource struct {
+	client api.PrefectClient
+}
+
+// BlockCollectionResourceModel defines the Terraform resource model.
+type BlockCollectionResourceModel struct {
+	// ID is generated by the provider, as a collection
+	// has no identifier of its own in Prefect.
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	Name                  types.String `tfsdk:"name"`
+	Version               types.String `tfsdk:"version"`
+	FailOnVersionMismatch types.Bool   `tfsdk:"fail_on_version_mismatch"`
+	RegisteredVersion     types.String `tfsdk:"registered_version"`
+
+	// BlockTypes is a map of block type slug to BlockCollectionBlockTypeModel.
+	BlockTypes types.Map `tfsdk:"block_types"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// BlockCollectionBlockTypeModel defines a single block type in the `block_types` map.
+type BlockCollectionBlockTypeModel struct {
+	BlockTypeID   customtypes.UUIDValue `tfsdk:"block_type_id"`
+	BlockSchemaID customtypes.UUIDValue `tfsdk:"block_schema_id"`
+	Checksum      types.String          `tfsdk:"checksum"`
+}
+
+// blockCollectionBlockTypeAttrTypes are the attribute types of
+// a single element in the `block_types` map.
+var blockCollectionBlockTypeAttrTypes = map[string]attr.Type{
+	"block_type_id":   customtypes.UUIDType{},
+	"block_schema_id": customtypes.UUIDType{},
+	"checksum":        types.StringType,
+}
+
+// NewBlockCollectionResource returns a new BlockCollectionResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewBlockCollectionResource() resource.Resource {
+	return &BlockCollectionResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *BlockCollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_collection"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *BlockCollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *BlockCollectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+The resource 'block_collection' registers all Block Types and Block Schemas of a Prefect collection,
+such as `+"`prefect-aws`"+`, from the collection's block metadata served by Prefect.
+<br>
+This is the equivalent of running `+"`prefect block register -m prefect_aws`"+`, without installing the collection.
+Block Types are registered before the Block Types that reference them, and existing Block Types are updated.
+<br>
+Prefect only serves the block metadata of the latest release of each collection. The `+"`version`"+` pins
+the expected release. Once a new release of the collection is published, Prefect serves that one instead:
+plans that register the collection report the mismatch as a warning, and the served release is registered,
+unless `+"`fail_on_version_mismatch`"+` is set, in which case they fail until `+"`version`"+` is updated.
+The release that was registered is exposed as `+"`registered_version`"+`.
+If a registered Block Type or Block Schema is deleted, the collection is registered again.
+<br>
+Destroying this resource only removes it from the Terraform state: the registered Block Types and
+Block Schemas are not deleted, as Blocks may still use them.
+<br>
+Custom blocks can still be managed with the `+"`prefect_block_type`"+` and `+"`prefect_block_schema`"+` resources.
+<br>
+For more information, see [register blocks](https://docs.prefect.io/v3/develop/blocks#register-blocks).
+`,
+			helpers.AllPlans...,
+		),
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Identifier of the registered collection (UUID), generated by the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the collection, as its Python package name (e.g. `prefect-aws`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "Pinned release of the collection (e.g. `0.5.0`). Prefect only serves the latest release: when it serves another one, the mismatch is reported as a warning and the served release is registered, unless `fail_on_version_mismatch` is set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"fail_on_version_mismatch": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to fail, rather than warn, when Prefect serves another release of the collection than the pinned `version`",
+			},
+			"registered_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Release of the collection that was registered, which differs from `version` when Prefect served another release",
+			},
+			"block_types": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Registered Block Types, keyed by Block Type slug",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"block_type_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block Type ID (UUID)",
+						},
+						"block_schema_id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Block Schema ID (UUID)",
+						},
+						"checksum": schema.StringAttribute{
+							Computed:    true,
+							Description: "Checksum of the Block Schema",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// ModifyPlan reports at plan time when Prefect serves another release of the
+// collection than the pinned version.
+func (r *BlockCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan BlockCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only check the served version when the pin changes,
+	// so that plans without changes don't issue additional API requests.
+	if !req.State.Raw.IsNull() {
+		var state BlockCollectionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Version.Equal(state.Version) && plan.FailOnVersionMismatch.Equal(state.FailOnVersionMismatch) {
+			return
+		}
+	}
+
+	// The served version can't be looked up until the collection and its location are known,
+	// for example when the workspace is created in the same apply.
+	if plan.Name.IsUnknown() || plan.Version.IsUnknown() || plan.FailOnVersionMismatch.IsUnknown() ||
+		plan.AccountID.IsUnknown() || plan.WorkspaceID.IsUnknown() {
+		return
+	}
+
+	collectionsClient, err := r.client.Collections(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Collections", err))
+
+		return
+	}
+
+	views, err := collectionsClient.GetBlockMetadataViews(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Collection", "plan", err))
+
+		return
+	}
+
+	_, servedVersion, err := collectionBlockTypes(views, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Unable to register collection",
+			fmt.Sprintf("Could not register collection %q: %s.", plan.Name.ValueString(), err),
+		)
+
+		return
+	}
+
+	if servedVersion != plan.Version.ValueString() {
+		resp.Diagnostics.Append(collectionVersionMismatchDiagnostic(plan, servedVersion))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *BlockCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BlockCollectionResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.ID = customtypes.NewUUIDValue(uuid.New())
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *BlockCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlockCollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	blockTypeClient, err := r.client.BlockTypes(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
+
+		return
+	}
+
+	blockSchemaClient, err := r.client.BlockSchemas(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
+
+		return
+	}
+
+	registered := make(map[string]BlockCollectionBlockTypeModel, len(state.BlockTypes.Elements()))
+	resp.Diagnostics.Append(state.BlockTypes.ElementsAs(ctx, &registered, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If any registered Block Type or Block Schema no longer exists,
+	// the resource is removed so that the collection is registered again.
+	for slug, registration := range registered {
+		blockType, err := blockTypeClient.GetBySlug(ctx, slug)
+		if err != nil {
+			if helpers.Is404Error(err) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Type", "get", err))
+
+			return
+		}
+
+		if blockType.ID != registration.BlockTypeID.ValueUUID() {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		_, err = blockSchemaClient.Read(ctx, registration.BlockSchemaID.ValueUUID())
+		if err != nil {
+			if helpers.Is404Error(err) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Schema", "get", err))
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *BlockCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BlockCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the Terraform state. The registered Block Types
+// and Block Schemas are kept, as Blocks may still use them.
+func (r *BlockCollectionResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// apply registers the Block Types and Block Schemas of the planned collection,
+// then copies the registered Block Types into the plan.
+func (r *BlockCollectionResource) apply(ctx context.Context, plan *BlockCollectionResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	collectionsClient, err := r.client.Collections(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Collections", err))
+
+		return diags
+	}
+
+	blockTypeClient, err := r.client.BlockTypes(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
+
+		return diags
+	}
+
+	blockSchemaClient, err := r.client.BlockSchemas(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
+
+		return diags
+	}
+
+	views, err := collectionsClient.GetBlockMetadataViews(ctx)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Block Collection", operation, err))
+
+		return diags
+	}
+
+	blockTypes, servedVersion, err := collectionBlockTypes(views, plan.Name.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Unable to register collection",
+			fmt.Sprintf("Could not register collection %q: %s.", plan.Name.ValueString(), err),
+		)
+
+		return diags
+	}
+
+	if servedVersion != plan.Version.ValueString() {
+		diags.Append(collectionVersionMismatchDiagnostic(*plan, servedVersion))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	registered := make(map[string]BlockCollectionBlockTypeModel, len(blockTypes))
+	for _, blockType := range blockTypes {
+		registration, err := registerCollectionBlockType(ctx, blockTypeClient, blockSchemaClient, blockType)
+		if err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Block Collection", operation, err))
+
+			return diags
+		}
+
+		registered[blockType.Slug] = registration
+	}
+
+	blockTypesValue, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: blockCollectionBlockTypeAttrTypes}, registered)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan.BlockTypes = blockTypesValue
+	plan.RegisteredVersion = types.StringValue(servedVersion)
+
+	return diags
+}
+
+// collectionVersionMismatchDiagnostic reports that Prefect serves another release of
+// the collection than the pinned one: as an error when fail_on_version_mismatch is set,
+// and as a warning otherwise, as the served release is registered instead.
+func collectionVersionMismatchDiagnostic(model BlockCollectionResourceModel, servedVersion string) diag.Diagnostic {
+	summary := "Collection version mismatch"
+	detail := fmt.Sprintf(
+		"Prefect serves version %s of the collection %q, but version %s is pinned. Prefect only serves the latest release of a collection.",
+		servedVersion,
+		model.Name.ValueString(),
+		model.Version.ValueString(),
+	)
+
+	if model.FailOnVersionMismatch.ValueBool() {
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("version"),
+			summary,
+			detail+fmt.Sprintf(" Set `version` to %q to register it.", servedVersion),
+		)
+	}
+
+	return diag.NewAttributeWarningDiagnostic(
+		path.Root("version"),
+		summary,
+		detail+fmt.Sprintf(" Version %s is registered instead. Set `version` to %q to silence this warning.", servedVersion, servedVersion),
+	)
+}
+
+// registerCollectionBlockType creates or updates a Block Type by slug,
+// then registers its Block Schema, reusing an existing Block Schema
+// with the same checksum.
+func registerCollectionBlockType(
+	ctx context.Context,
+	blockTypeClient api.BlockTypeClient,
+	blockSchemaClient api.BlockSchemaClient,
+	blockType api.CollectionBlockType,
+) (BlockCollectionBlockTypeModel, error) {
+	existing, err := blockTypeClient.GetBySlug(ctx, blockType.Slug)
+
+	switch {
+	case err != nil && !helpers.Is404Error(err):
+		return BlockCollectionBlockTypeModel{}, fmt.Errorf("failed to get block type %q: %w", blockType.Slug, err)
+
+	case err != nil:
+		existing, err = blockTypeClient.Create(ctx, &api.BlockTypeCreate{
+			Name:             blockType.Name,
+			Slug:             blockType.Slug,
+			LogoURL:          blockType.LogoURL,
+			DocumentationURL: blockType.DocumentationURL,
+			Description:      blockType.Description,
+			CodeExample:      blockType.CodeExample,
+		})
+		if err != nil {
+			return BlockCollectionBlockTypeModel{}, fmt.Errorf("failed to create block type %q: %w", blockType.Slug, err)
+		}
+
+	// Protected Block Types are managed by Prefect itself, and cannot be updated.
+	case !existing.IsProtected:
+		err = blockTypeClient.Update(ctx, existing.ID, &api.BlockTypeUpdate{
+			LogoURL:          blockType.LogoURL,
+			DocumentationURL: blockType.DocumentationURL,
+			Description:      blockType.Description,
+			CodeExample:      blockType.CodeExample,
+		})
+		if err != nil {
+			return BlockCollectionBlockTypeModel{}, fmt.Errorf("failed to update block type %q: %w", blockType.Slug, err)
+		}
+	}
+
+	blockSchemas, err := blockSchemaClient.List(ctx, []uuid.UUID{existing.ID})
+	if err != nil {
+		return BlockCollectionBlockTypeModel{}, fmt.Errorf("failed to list block schemas for block type %q: %w", blockType.Slug, err)
+	}
+
+	blockSchema := blockSchemaWithChecksum(blockSchemas, blockType.BlockSchema.Checksum)
+	if blockSchema == nil {
+		blockSchema, err = blockSchemaClient.Create(ctx, &api.BlockSchemaCreate{
+			BlockTypeID:  existing.ID,
+			Capabilities: blockType.BlockSchema.Capabilities,
+			Version:      blockType.BlockSchema.Version,
+			Fields:       blockType.BlockSchema.Fields,
+		})
+		if err != nil {
+			return BlockCollectionBlockTypeModel{}, fmt.Errorf("failed to create block schema for block type %q: %w", blockType.Slug, err)
+		}
+	}
+
+	return BlockCollectionBlockTypeModel{
+		BlockTypeID:   customtypes.NewUUIDValue(existing.ID),
+		BlockSchemaID: customtypes.NewUUIDValue(blockSchema.ID),
+		Checksum:      types.StringValue(blockSchema.Checksum),
+	}, nil
+}
+
+// blockSchemaWithChecksum returns the Block Schema with the given checksum,
+// or nil if none matches.
+func blockSchemaWithChecksum(blockSchemas []*api.BlockSchema, checksum string) *api.BlockSchema {
+	for _, blockSchema := range blockSchemas {
+		if blockSchema.Checksum == checksum {
+			return blockSchema
+		}
+	}
+
+	return nil
+}
+
+// collectionBlockTypes returns the Block Types of a collection, ordered so that every
+// Block Type comes after the Block Types it references, and the served version.
+func collectionBlockTypes(views api.BlockTypeByPackage, name string) ([]api.CollectionBlockType, string, error) {
+	collection, ok := views[name]
+	if !ok || len(collection.BlockTypes) == 0 {
+		return nil, "", fmt.Errorf("no block metadata is served for the collection %q", name)
+	}
+
+	// All Block Schemas of a collection are served at the release's version.
+	var version string
+	for _, slug := range slices.Sorted(maps.Keys(collection.BlockTypes)) {
+		served := collection.BlockTypes[slug].BlockSchema.Version
+		if version != "" && served != version {
+			return nil, "", fmt.Errorf("the block metadata of the collection %q is served at both versions %s and %s", name, version, served)
+		}
+
+		version = served
+	}
+
+	blockTypes, err := sortCollectionBlockTypes(collection.BlockTypes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return blockTypes, version, nil
+}
+
+// sortCollectionBlockTypes orders Block Types so that every Block Type comes
+// after the Block Types of the same collection it references, then by slug.
+func sortCollectionBlockTypes(blockTypes map[string]api.CollectionBlockType) ([]api.CollectionBlockType, error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	sorted := make([]api.CollectionBlockType, 0, len(blockTypes))
+	states := make(map[string]int, len(blockTypes))
+
+	var visit func(slug string, chain []string) error
+	visit = func(slug string, chain []string) error {
+		switch states[slug] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("block types reference each other: %s", strings.Join(append(chain, slug), " -> "))
+		}
+
+		states[slug] = visiting
+
+		for _, reference := range blockSchemaReferencedSlugs(blockTypes[slug].BlockSchema.Fields) {
+			// References to Block Types of other collections are expected to be registered already.
+			if _, ok := blockTypes[reference]; !ok {
+				continue
+			}
+
+			if err := visit(reference, append(chain, slug)); err != nil {
+				return err
+			}
+		}
+
+		states[slug] = visited
+		sorted = append(sorted, blockTypes[slug])
+
+		return nil
+	}
+
+	for _, slug := range slices.Sorted(maps.Keys(blockTypes)) {
+		if err := visit(slug, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
+
+// blockSchemaReferencedSlugs returns the sorted slugs of the Block Types
+// referenced in the `block_schema_references` of Block Schema fields.
+// A reference is either a single Block Schema, or a list of them for unions.
+func blockSchemaReferencedSlugs(fields any) []string {
+	fieldsMap, ok := fields.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	references, ok := fieldsMap["block_schema_references"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	slugs := map[string]struct{}{}
+	addSlug := func(reference any) {
+		if referenceMap, ok := reference.(map[string]any); ok {
+			if slug, ok := referenceMap["block_type_slug"].(string); ok {
+				slugs[slug] = struct{}{}
+			}
+		}
+	}
+
+	for _, reference := range references {
+		if referenceList, ok := reference.([]any); ok {
+			for _, item := range referenceList {
+				addSlug(item)
+			}
+
+			continue
+		}
+
+		addSlug(reference)
+	}
+
+	return slices.Sorted(maps.Keys(slugs))
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectionBlockType returns a block type of version 0.5.0,
+// with Block Schema fields referencing the given slugs.
+func collectionBlockType(slug string, references map[string]any) api.CollectionBlockType {
+	return api.CollectionBlockType{
+		Name: slug,
+		Slug: slug,
+		BlockSchema: api.CollectionBlockSchema{
+			Version: "0.5.0",
+			Fields: map[string]any{
+				"block_schema_references": references,
+			},
+		},
+	}
+}
+
+func TestCollectionBlockTypes(t *testing.T) {
+	t.Parallel()
+
+	views := api.BlockTypeByPackage{
+		"prefect-aws": {
+			BlockTypes: map[string]api.CollectionBlockType{
+				"s3-bucket": collectionBlockType("s3-bucket", map[string]any{
+					"credentials": []any{
+						map[string]any{"block_type_slug": "aws-credentials", "block_schema_checksum": "sha256:a"},
+						map[string]any{"block_type_slug": "minio-credentials", "block_schema_checksum": "sha256:b"},
+					},
+				}),
+				"aws-credentials": collectionBlockType("aws-credentials", map[string]any{
+					"aws_client_parameters": map[string]any{"block_type_slug": "aws-client-parameters"},
+				}),
+				"minio-credentials":     collectionBlockType("minio-credentials", nil),
+				"aws-client-parameters": collectionBlockType("aws-client-parameters", nil),
+				"aws-secret": collectionBlockType("aws-secret", map[string]any{
+					// Block Types of other collections are left out of the ordering.
+					"secret": map[string]any{"block_type_slug": "secret"},
+				}),
+			},
+		},
+	}
+
+	blockTypes, servedVersion, err := collectionBlockTypes(views, "prefect-aws")
+	require.NoError(t, err)
+	assert.Equal(t, "0.5.0", servedVersion)
+
+	slugs := make([]string, 0, len(blockTypes))
+	for _, blockType := range blockTypes {
+		slugs = append(slugs, blockType.Slug)
+	}
+
+	assert.Equal(t, []string{
+		"aws-client-parameters",
+		"aws-credentials",
+		"aws-secret",
+		"minio-credentials",
+		"s3-bucket",
+	}, slugs)
+
+	_, _, err = collectionBlockTypes(views, "prefect-gcp")
+	require.ErrorContains(t, err, `no block metadata is served for the collection "prefect-gcp"`)
+
+	mixed := collectionBlockType("aws-secret", nil)
+	mixed.BlockSchema.Version = "0.4.0"
+	views["prefect-aws"].BlockTypes["aws-secret"] = mixed
+
+	_, _, err = collectionBlockTypes(views, "prefect-aws")
+	require.ErrorContains(t, err, "served at both versions")
+}
+
+func TestCollectionVersionMismatchDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	model := BlockCollectionResourceModel{
+		Name:                  types.StringValue("prefect-aws"),
+		Version:               types.StringValue("0.4.0"),
+		FailOnVersionMismatch: types.BoolValue(false),
+	}
+
+	warning := collectionVersionMismatchDiagnostic(model, "0.5.0")
+	assert.Equal(t, diag.SeverityWarning, warning.Severity())
+	assert.Contains(t, warning.Detail(), "Version 0.5.0 is registered instead")
+
+	model.FailOnVersionMismatch = types.BoolValue(true)
+
+	failure := collectionVersionMismatchDiagnostic(model, "0.5.0")
+	assert.Equal(t, diag.SeverityError, failure.Severity())
+	assert.Contains(t, failure.Detail(), `Set `+"`version`"+` to "0.5.0"`)
+}
+
+func TestSortCollectionBlockTypesCycle(t *testing.T) {
+	t.Parallel()
+
+	_, err := sortCollectionBlockTypes(map[string]api.CollectionBlockType{
+		"a": collectionBlockType("a", map[string]any{"b": map[string]any{"block_type_slug": "b"}}),
+		"b": collectionBlockType("b", map[string]any{"a": map[string]any{"block_type_slug": "a"}}),
+	})
+	require.ErrorContains(t, err, "a -> b -> a")
+}
+
+func TestBlockSchemaReferencedSlugs(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, blockSchemaReferencedSlugs(nil))
+	assert.Nil(t, blockSchemaReferencedSlugs(map[string]any{"properties": map[string]any{}}))
+	assert.Equal(t, []string{"aws-credentials", "minio-credentials"}, blockSchemaReferencedSlugs(map[string]any{
+		"block_schema_references": map[string]any{
+			"credentials": []any{
+				map[string]any{"block_type_slug": "minio-credentials"},
+				map[string]any{"block_type_slug": "aws-credentials"},
+			},
+			"fallback": map[string]any{"block_type_slug": "aws-credentials"},
+		},
+	}))
+}
+
+func TestBlockSchemaWithChecksum(t *testing.T) {
+	t.Parallel()
+
+	blockSchemas := []*api.BlockSchema{
+		{Checksum: "sha256:aaa", Version: "0.4.0"},
+		{Checksum: "sha256:bbb", Version: "0.5.0"},
+	}
+
+	assert.Equal(t, blockSchemas[1], blockSchemaWithChecksum(blockSchemas, "sha256:bbb"))
+	assert.Nil(t, blockSchemaWithChecksum(blockSchemas, "sha256:ccc"))
+	assert.Nil(t, blockSchemaWithChecksum(nil, "sha256:aaa"))
+}
@@ -0,0 +1,125 @@
+package resources_test
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+type blockCollectionFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+
+	Name                  string
+	Version               string
+	FailOnVersionMismatch bool
+}
+
+func fixtureAccBlockCollection(cfg blockCollectionFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_block_collection" "test" {
+	name = "{{ .Name }}"
+	version = "{{ .Version }}"
+	{{- if .FailOnVersionMismatch }}
+	fail_on_version_mismatch = true
+	{{- end }}
+
+	{{ .WorkspaceIDArg }}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_collection(t *testing.T) {
+	// The served version is fetched before the test case runs, as it is part of the configuration.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	workspace := testutils.NewEphemeralWorkspace()
+	blockCollectionResourceName := "prefect_block_collection.test"
+
+	cfg := blockCollectionFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+
+		Name: "prefect-aws",
+	}
+
+	// Prefect only serves the latest release of a collection, so the test pins that one.
+	cfg.Version = testAccServedCollectionVersion(t, cfg.Name)
+
+	cfgMismatch := cfg
+	cfgMismatch.Version = "0.0.0"
+
+	cfgMismatchFail := cfgMismatch
+	cfgMismatchFail.FailOnVersionMismatch = true
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that a pinned version other than the served one fails when opted in
+				Config:      fixtureAccBlockCollection(cfgMismatchFail),
+				ExpectError: regexp.MustCompile(`Collection version mismatch`),
+			},
+			{
+				// Check that a pinned version other than the served one registers the served version
+				Config: fixtureAccBlockCollection(cfgMismatch),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(blockCollectionResourceName, "version", cfgMismatch.Version),
+					testutils.ExpectKnownValue(blockCollectionResourceName, "registered_version", cfg.Version),
+					testutils.ExpectKnownValueNotNull(blockCollectionResourceName, "block_types.s3-bucket.block_schema_id"),
+				},
+			},
+			{
+				// Check registration of the collection's block types and schemas
+				Config: fixtureAccBlockCollection(cfg),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNotNull(blockCollectionResourceName, "id"),
+					testutils.ExpectKnownValue(blockCollectionResourceName, "name", cfg.Name),
+					testutils.ExpectKnownValue(blockCollectionResourceName, "registered_version", cfg.Version),
+					testutils.ExpectKnownValueNotNull(blockCollectionResourceName, "block_types.aws-credentials.block_type_id"),
+					testutils.ExpectKnownValueNotNull(blockCollectionResourceName, "block_types.s3-bucket.block_schema_id"),
+					testutils.ExpectKnownValueNotNull(blockCollectionResourceName, "block_types.s3-bucket.checksum"),
+				},
+			},
+		},
+	})
+}
+
+// testAccServedCollectionVersion returns the version of a collection's
+// block metadata served by Prefect.
+func testAccServedCollectionVersion(t *testing.T, name string) string {
+	t.Helper()
+
+	c, _ := testutils.NewTestClient()
+	collectionsClient, err := c.Collections(uuid.Nil, uuid.Nil)
+	if err != nil {
+		t.Fatalf("error creating collections client: %s", err)
+	}
+
+	views, err := collectionsClient.GetBlockMetadataViews(context.Background())
+	if err != nil {
+		t.Fatalf("error fetching block metadata: %s", err)
+	}
+
+	for _, blockType := range views[name].BlockTypes {
+		return blockType.BlockSchema.Version
+	}
+
+	t.Fatalf("no block metadata served for collection %q", name)
+
+	return ""
+}
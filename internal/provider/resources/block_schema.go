@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +37,8 @@ type BlockSchemaResourceModel struct {
 	BlockType    types.String          `tfsdk:"block_type"`
 	Capabilities types.List            `tfsdk:"capabilities"`
 	Version      types.String          `tfsdk:"version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewBlockSchemaResource returns a new BlockSchemaResource.
@@ -67,7 +70,7 @@ func (r *BlockSchemaResource) Configure(_ context.Context, req resource.Configur
 }
 
 // Schema returns the schema for the resource.
-func (r *BlockSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BlockSchemaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `block_schema` allows creating and managing [Prefect Block Schemas](https://docs.prefect.io/latest/concepts/blocks/).",
@@ -150,6 +153,9 @@ func (r *BlockSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -193,6 +199,15 @@ func (r *BlockSchemaResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	blockSchemaClient, err := r.client.BlockSchemas(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
@@ -248,6 +263,15 @@ func (r *BlockSchemaResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	blockSchemaClient, err := r.client.BlockSchemas(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
@@ -284,6 +308,15 @@ func (r *BlockSchemaResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	blockSchemaClient, err := r.client.BlockSchemas(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Schema", err))
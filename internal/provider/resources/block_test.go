@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -84,6 +86,50 @@ resource "prefect_block" "with_ref" {
 	return testutils.RenderTemplate(tmpl, cfg)
 }
 
+func fixtureAccBlockWithReferences(cfg blockFixtureConfig, field string) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_block" "credentials" {
+	name = "{{ .BlockName }}-credentials"
+	type_slug = "aws-credentials"
+	data = jsonencode({
+		"region_name" = "us-east-1"
+	})
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_block" "bucket" {
+	name = "{{ .BlockName }}-bucket"
+	type_slug = "s3-bucket"
+	data = jsonencode({
+		"bucket_name" = "{{ .BlockValue }}"
+	})
+	references = {
+		"FIELD" = prefect_block.credentials.id
+	}
+	{{ .WorkspaceIDArg }}
+}`
+
+	return testutils.RenderTemplate(strings.ReplaceAll(tmpl, "FIELD", field), cfg)
+}
+
+func fixtureAccBlockWithInvalidData(cfg blockFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_block" "{{ .BlockName }}" {
+	name = "{{ .BlockName }}"
+	type_slug = "secret"
+	data = jsonencode({
+		"secret_value" = "{{ .BlockValue }}"
+	})
+	{{ .WorkspaceIDArg }}
+}`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_block(t *testing.T) {
 	randomName := testutils.NewRandomPrefixedString()
@@ -271,6 +317,18 @@ func TestAccResource_block(t *testing.T) {
 				PlanOnly:           true,
 				ExpectNonEmptyPlan: false,
 			},
+			// Block data is validated against the Block schema during plan,
+			// once the workspace is known.
+			{
+				Config: fixtureAccBlockWithInvalidData(blockFixtureConfig{
+					Workspace:      workspace.Resource,
+					WorkspaceIDArg: workspace.IDArg,
+					BlockName:      randomName,
+					BlockValue:     randomValue2,
+				}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`value`: required field is missing"),
+			},
 			{
 				// Import State checks - import by block_id,workspace_id (dynamic)
 				ImportState:       true,
@@ -286,6 +344,50 @@ func TestAccResource_block(t *testing.T) {
 	})
 }
 
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_block_references(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := blockFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+		BlockName:      testutils.NewRandomPrefixedString(),
+		BlockValue:     "my-bucket",
+	}
+
+	var blockDocument api.BlockDocument
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the field must be declared as a Block reference by the schema
+				Config:      fixtureAccBlockWithReferences(cfg, "bucket_folder"),
+				ExpectError: regexp.MustCompile("does not reference a Block"),
+			},
+			{
+				// Check creation of a Block referencing another Block
+				Config: fixtureAccBlockWithReferences(cfg, "credentials"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBlockExists("prefect_block.bucket", &blockDocument),
+					resource.TestCheckResourceAttrPair("prefect_block.bucket", "references.credentials", "prefect_block.credentials", "id"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// The referenced data is left out of the data.
+					testutils.ExpectKnownValue("prefect_block.bucket", "data", `{"bucket_name":"my-bucket"}`),
+				},
+			},
+			{
+				// Check that the references don't result in an Update plan if no changes are made
+				Config:             fixtureAccBlockWithReferences(cfg, "credentials"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 // testAccCheckBlockExists is a Custom Check Function that
 // verifies that the API object was created correctly.
 func testAccCheckBlockExists(blockResourceName string, blockDocument *api.BlockDocument) resource.TestCheckFunc {
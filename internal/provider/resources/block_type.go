@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -34,6 +35,8 @@ type BlockTypeResourceModel struct {
 	CodeExample      types.String `tfsdk:"code_example"`
 
 	IsProtected types.Bool `tfsdk:"is_protected"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewBlockTypeResource returns a new BlockTypeResource.
@@ -65,7 +68,7 @@ func (r *BlockTypeResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 // Schema returns the schema for the resource.
-func (r *BlockTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *BlockTypeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `block_type` allows creating and managing [Prefect Block Types](https://docs.prefect.io/latest/concepts/blocks/).",
@@ -134,6 +137,9 @@ func (r *BlockTypeResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Whether the block type is protected. Protected block types cannot be modified via API.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -163,6 +169,15 @@ func (r *BlockTypeResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	blockTypeClient, err := r.client.BlockTypes(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
@@ -203,6 +218,15 @@ func (r *BlockTypeResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	if state.ID.IsNull() {
 		resp.Diagnostics.AddError(
 			"ID is unset",
@@ -261,6 +285,15 @@ func (r *BlockTypeResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	blockTypeClient, err := r.client.BlockTypes(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
@@ -322,6 +355,15 @@ func (r *BlockTypeResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	blockTypeClient, err := r.client.BlockTypes(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Block Type", err))
@@ -336,6 +378,17 @@ func (r *BlockTypeResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	// Wait until the block type is gone, so a block type with the same slug
+	// can be created in the same apply.
+	err = helpers.WaitForResourceDeletion(ctx, func(ctx context.Context) (*api.BlockType, error) {
+		return blockTypeClient.Get(ctx, state.ID.ValueUUID())
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Block Type", "delete", err))
+
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -0,0 +1,575 @@
+package resources
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// blockDataValidator validates a Block document payload against the `fields`
+// of a Block schema. The `fields` value is the JSON schema that Pydantic
+// generates for the Python Block class (`Block.schema()`), extended by Prefect
+// with `block_type_slug` and `secret_fields`.
+//
+// Only the subset of JSON schema that Pydantic emits for Blocks is supported:
+// `type`, `enum`, `properties`, `required`, `additionalProperties`, `items`,
+// `anyOf` / `oneOf` / `allOf` and local `$ref` pointers into `definitions` or `$defs`.
+// Anything else is accepted as-is, so that the server remains the source of truth
+// for constructs we don't understand.
+type blockDataValidator struct {
+	root map[string]any
+
+	errors   []string
+	warnings []string
+}
+
+// validateBlockData checks the decoded Block data against the schema fields,
+// returning attribute diagnostics scoped to the given attribute (`data` or `data_wo`).
+//
+// Missing required fields, type mismatches and invalid block references are
+// reported as errors. Keys that are not part of the schema are reported as a
+// warning, because the API stores them as-is but no Block class will read them.
+func validateBlockData(fields any, data map[string]any, attribute string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	root, ok := fields.(map[string]any)
+	if !ok {
+		return diags
+	}
+
+	v := &blockDataValidator{root: root}
+	v.validateObject(root, data, "", true)
+
+	if len(v.errors) > 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Block data",
+			fmt.Sprintf("The Block data does not match the latest schema for the `%s` block type:\n\n- %s",
+				blockTypeSlugFromFields(root), strings.Join(v.errors, "\n- ")),
+		)
+	}
+
+	if len(v.warnings) > 0 {
+		diags.AddAttributeWarning(
+			path.Root(attribute),
+			"Unknown Block data fields",
+			fmt.Sprintf("The following fields are not part of the latest schema for the `%s` block type, and will be ignored by Prefect:\n\n- %s",
+				blockTypeSlugFromFields(root), strings.Join(v.warnings, "\n- ")),
+		)
+	}
+
+	return diags
+}
+
+// blockSecretFields returns the secret fields declared by the schema
+// (`secret_fields`) that are set in the decoded Block data. Secret fields are
+// dot-separated paths, where `*` matches any key in a nested object.
+func blockSecretFields(fields any, data map[string]any) []string {
+	root, ok := fields.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	secretFields, ok := root["secret_fields"].([]any)
+	if !ok {
+		return nil
+	}
+
+	found := []string{}
+	for _, secretField := range secretFields {
+		secretPath, ok := secretField.(string)
+		if !ok {
+			continue
+		}
+
+		found = append(found, matchSecretPath(data, strings.Split(secretPath, "."), "")...)
+	}
+
+	sort.Strings(found)
+
+	return slices.Compact(found)
+}
+
+// matchSecretPath walks the data along a secret field path and returns the
+// concrete paths that are set. Values that are block references are skipped,
+// because the secret lives in the referenced Block rather than in this one.
+func matchSecretPath(value any, segments []string, prefix string) []string {
+	if len(segments) == 0 {
+		if value == nil || isBlockReference(value) {
+			return nil
+		}
+
+		return []string{prefix}
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok || isBlockReference(object) {
+		return nil
+	}
+
+	keys := []string{segments[0]}
+	if segments[0] == "*" {
+		keys = make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+	}
+
+	matches := []string{}
+	for _, key := range keys {
+		child, ok := object[key]
+		if !ok {
+			continue
+		}
+
+		matches = append(matches, matchSecretPath(child, segments[1:], joinBlockDataPath(prefix, key))...)
+	}
+
+	return matches
+}
+
+// blockTypeSlugFromFields returns the block type slug embedded in the schema fields.
+func blockTypeSlugFromFields(fields map[string]any) string {
+	slug, _ := fields["block_type_slug"].(string)
+
+	return slug
+}
+
+// validateBlockReferences checks the fields of the `references` attribute against the
+// `block_schema_references` of the schema fields. referencedSlugs maps a field to the
+// type slug of the Block document it references, when known, which must be one of the
+// Block types accepted by the field.
+func validateBlockReferences(fields any, references []string, referencedSlugs map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	root, ok := fields.(map[string]any)
+	if !ok {
+		return diags
+	}
+
+	accepted := blockSchemaReferenceSlugs(root)
+
+	for _, field := range references {
+		slugs, ok := accepted[field]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("references").AtMapKey(field),
+				"Invalid Block reference",
+				fmt.Sprintf("Field `%s` does not reference a Block in the latest schema for the `%s` block type.", field, blockTypeSlugFromFields(root)),
+			)
+
+			continue
+		}
+
+		slug, ok := referencedSlugs[field]
+		if ok && len(slugs) > 0 && !slices.Contains(slugs, slug) {
+			diags.AddAttributeError(
+				path.Root("references").AtMapKey(field),
+				"Invalid Block reference",
+				fmt.Sprintf("Field `%s` references a Block of type `%s`, expected %s.", field, slug, "`"+strings.Join(slugs, "`, `")+"`"),
+			)
+		}
+	}
+
+	return diags
+}
+
+// blockSchemaReferenceSlugs returns the fields of the schema that reference another
+// Block (`block_schema_references`), with the Block type slugs they accept. A field
+// that accepts several Block types is declared with a list of references.
+func blockSchemaReferenceSlugs(fields map[string]any) map[string][]string {
+	references, _ := fields["block_schema_references"].(map[string]any)
+
+	slugs := make(map[string][]string, len(references))
+	for field, reference := range references {
+		candidates := []any{reference}
+		if list, ok := reference.([]any); ok {
+			candidates = list
+		}
+
+		slugs[field] = []string{}
+		for _, candidate := range candidates {
+			object, _ := candidate.(map[string]any)
+			if slug, ok := object["block_type_slug"].(string); ok {
+				slugs[field] = append(slugs[field], slug)
+			}
+		}
+
+		sort.Strings(slugs[field])
+	}
+
+	return slugs
+}
+
+// isBlockReference reports whether a value is a `{"$ref": ...}` expression
+// pointing to another Block document.
+func isBlockReference(value any) bool {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	_, ok = object["$ref"]
+
+	return ok
+}
+
+// blockReferenceID extracts the Block document ID from a `$ref` expression,
+// which is either `{"$ref": "<id>"}` or `{"$ref": {"block_document_id": "<id>"}}`.
+func blockReferenceID(value map[string]any) (string, bool) {
+	switch ref := value["$ref"].(type) {
+	case string:
+		return ref, true
+	case map[string]any:
+		id, ok := ref["block_document_id"].(string)
+
+		return id, ok
+	}
+
+	return "", false
+}
+
+// joinBlockDataPath builds the human-readable path of a nested key.
+func joinBlockDataPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
+
+// resolve follows local `$ref` pointers (and single-element `allOf` wrappers,
+// which Pydantic uses to attach a description to a referenced model) until it
+// reaches a concrete schema.
+func (v *blockDataValidator) resolve(schema map[string]any) map[string]any {
+	for range 32 {
+		if allOf, ok := schema["allOf"].([]any); ok && len(allOf) == 1 {
+			if inner, ok := allOf[0].(map[string]any); ok {
+				schema = inner
+
+				continue
+			}
+		}
+
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema
+		}
+
+		target := v.lookup(ref)
+		if target == nil {
+			return schema
+		}
+
+		schema = target
+	}
+
+	return schema
+}
+
+// lookup resolves a local JSON pointer such as `#/definitions/AwsCredentials`.
+func (v *blockDataValidator) lookup(ref string) map[string]any {
+	pointer, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return nil
+	}
+
+	var current any = v.root
+	for segment := range strings.SplitSeq(pointer, "/") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		current = object[segment]
+	}
+
+	target, _ := current.(map[string]any)
+
+	return target
+}
+
+// validateObject validates a JSON object against an object schema.
+// Unknown keys are only reported when the schema declares its properties
+// and does not allow additional ones.
+func (v *blockDataValidator) validateObject(schema map[string]any, data map[string]any, prefix string, isBlock bool) {
+	properties, hasProperties := schema["properties"].(map[string]any)
+
+	required, _ := schema["required"].([]any)
+	for _, field := range required {
+		name, ok := field.(string)
+		if !ok {
+			continue
+		}
+
+		if _, ok := data[name]; !ok {
+			v.errors = append(v.errors, fmt.Sprintf("`%s`: required field is missing", joinBlockDataPath(prefix, name)))
+		}
+	}
+
+	additional, hasAdditional := schema["additionalProperties"]
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := data[key]
+		fieldPath := joinBlockDataPath(prefix, key)
+
+		propertySchema, ok := properties[key].(map[string]any)
+		if !ok {
+			if additionalSchema, ok := additional.(map[string]any); ok {
+				v.validateValue(additionalSchema, value, fieldPath)
+
+				continue
+			}
+
+			if allowed, ok := additional.(bool); ok && !allowed {
+				v.errors = append(v.errors, fmt.Sprintf("`%s`: field is not allowed", fieldPath))
+
+				continue
+			}
+
+			if hasProperties && !hasAdditional && isBlock {
+				v.warnings = append(v.warnings, fmt.Sprintf("`%s`", fieldPath))
+			}
+
+			continue
+		}
+
+		// Optional fields may always be explicitly set to null.
+		if value == nil && !slices.Contains(required, any(key)) {
+			continue
+		}
+
+		v.validateValue(propertySchema, value, fieldPath)
+	}
+}
+
+// validateValue validates a single JSON value against a schema node.
+func (v *blockDataValidator) validateValue(schema map[string]any, value any, fieldPath string) {
+	if object, ok := value.(map[string]any); ok && isBlockReference(object) {
+		v.validateBlockReference(object, fieldPath)
+
+		return
+	}
+
+	schema = v.resolve(schema)
+
+	for _, key := range []string{"anyOf", "oneOf"} {
+		if branches, ok := schema[key].([]any); ok && len(branches) > 0 {
+			v.validateUnion(branches, value, fieldPath)
+
+			return
+		}
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		if !slices.ContainsFunc(enum, func(candidate any) bool { return jsonValuesEqual(candidate, value) }) {
+			v.errors = append(v.errors, fmt.Sprintf("`%s`: value %s is not one of %s", fieldPath, describeJSONValue(value), describeJSONEnum(enum)))
+
+			return
+		}
+	}
+
+	types := schemaTypes(schema)
+	if len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return jsonValueHasType(value, t) }) {
+		v.errors = append(v.errors, fmt.Sprintf("`%s`: expected %s, got %s", fieldPath, strings.Join(types, " or "), jsonValueType(value)))
+
+		return
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]any:
+		_, isBlock := schema["block_type_slug"]
+		if _, hasProperties := schema["properties"]; hasProperties || schema["additionalProperties"] != nil {
+			v.validateObject(schema, typedValue, fieldPath, isBlock)
+		}
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range typedValue {
+				v.validateValue(items, item, fieldPath+"["+strconv.Itoa(i)+"]")
+			}
+		}
+	}
+}
+
+// validateUnion validates a value against `anyOf` / `oneOf` branches,
+// accepting the value as soon as one branch matches.
+//
+// Optional fields are represented as a union with `null`; for those, the
+// errors of the single non-null branch are reported as they are more useful
+// than a generic mismatch.
+func (v *blockDataValidator) validateUnion(branches []any, value any, fieldPath string) {
+	branchErrors := [][]string{}
+
+	for _, branch := range branches {
+		branchSchema, ok := branch.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		branchValidator := &blockDataValidator{root: v.root}
+		branchValidator.validateValue(branchSchema, value, fieldPath)
+		if len(branchValidator.errors) == 0 {
+			v.warnings = append(v.warnings, branchValidator.warnings...)
+
+			return
+		}
+
+		if !slices.Equal(schemaTypes(branchSchema), []string{"null"}) {
+			branchErrors = append(branchErrors, branchValidator.errors)
+		}
+	}
+
+	if len(branchErrors) == 1 {
+		v.errors = append(v.errors, branchErrors[0]...)
+
+		return
+	}
+
+	v.errors = append(v.errors, fmt.Sprintf("`%s`: value %s does not match any of the allowed types", fieldPath, describeJSONValue(value)))
+}
+
+// validateBlockReference checks that a `$ref` expression points to a valid
+// Block document ID. The API resolves the reference server-side, so the
+// referenced Block's data is not validated here.
+func (v *blockDataValidator) validateBlockReference(value map[string]any, fieldPath string) {
+	id, ok := blockReferenceID(value)
+	if !ok {
+		v.errors = append(v.errors, fmt.Sprintf("`%s`: Block reference must be in the form `{\"$ref\": {\"block_document_id\": \"<id>\"}}`", fieldPath))
+
+		return
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		v.errors = append(v.errors, fmt.Sprintf("`%s`: Block reference %q is not a valid Block ID (UUID)", fieldPath, id))
+	}
+}
+
+// schemaTypes returns the JSON types allowed by a schema node,
+// which may be declared as a single string or a list of strings.
+func schemaTypes(schema map[string]any) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+
+		return types
+	}
+
+	return nil
+}
+
+// jsonValueHasType reports whether a decoded JSON value matches a JSON schema type.
+func jsonValueHasType(value any, jsonType string) bool {
+	switch jsonType {
+	case "string":
+		_, ok := value.(string)
+
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := value.(float64)
+
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+
+		return ok
+	case "array":
+		_, ok := value.([]any)
+
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+
+		return ok
+	case "null":
+		return value == nil
+	}
+
+	// Unknown types are accepted, leaving validation to the server.
+	return true
+}
+
+// jsonValueType returns the JSON schema type name of a decoded JSON value.
+func jsonValueType(value any) string {
+	switch typedValue := value.(type) {
+	case string:
+		return "string"
+	case float64:
+		if typedValue == math.Trunc(typedValue) {
+			return "integer"
+		}
+
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case nil:
+		return "null"
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+// jsonValuesEqual compares two scalar decoded JSON values.
+func jsonValuesEqual(a, b any) bool {
+	switch a.(type) {
+	case map[string]any, []any:
+		return false
+	}
+
+	switch b.(type) {
+	case map[string]any, []any:
+		return false
+	}
+
+	return a == b
+}
+
+// describeJSONValue formats a scalar value for use in a diagnostic.
+func describeJSONValue(value any) string {
+	switch typedValue := value.(type) {
+	case string:
+		return strconv.Quote(typedValue)
+	case map[string]any, []any:
+		return jsonValueType(value)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// describeJSONEnum formats enum values for use in a diagnostic.
+func describeJSONEnum(enum []any) string {
+	values := make([]string, 0, len(enum))
+	for _, value := range enum {
+		values = append(values, describeJSONValue(value))
+	}
+
+	return "[" + strings.Join(values, ", ") + "]"
+}
@@ -0,0 +1,327 @@
+package resources // nolint:testpackage // need access to private validateBlockData function
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// s3BucketFields is a trimmed-down version of the `s3-bucket` block schema
+// fields, as generated by Pydantic and registered by prefect-aws.
+const s3BucketFields = `{
+	"title": "S3Bucket",
+	"type": "object",
+	"block_type_slug": "s3-bucket",
+	"secret_fields": ["credentials.aws_secret_access_key"],
+	"block_schema_references": {
+		"credentials": {"block_type_slug": "aws-credentials", "block_schema_checksum": "sha256:abc"}
+	},
+	"properties": {
+		"bucket_name": {"title": "Bucket Name", "type": "string"},
+		"bucket_folder": {"title": "Bucket Folder", "default": "", "type": "string"},
+		"credentials": {"$ref": "#/definitions/AwsCredentials"},
+		"retries": {"anyOf": [{"type": "integer"}, {"type": "null"}]},
+		"endpoint": {"anyOf": [{"type": "string"}, {"type": "integer"}]},
+		"storage_class": {"enum": ["STANDARD", "GLACIER"], "type": "string"},
+		"tags": {"type": "array", "items": {"type": "string"}},
+		"extra_args": {"type": "object", "additionalProperties": {"type": "string"}}
+	},
+	"required": ["bucket_name"],
+	"definitions": {
+		"AwsCredentials": {
+			"title": "AwsCredentials",
+			"type": "object",
+			"block_type_slug": "aws-credentials",
+			"secret_fields": ["aws_secret_access_key"],
+			"properties": {
+				"aws_access_key_id": {"type": "string"},
+				"aws_secret_access_key": {"type": "string", "format": "password", "writeOnly": true},
+				"region_name": {"type": "string"}
+			}
+		}
+	}
+}`
+
+func decodeJSONForTest(t *testing.T, value string) map[string]any {
+	t.Helper()
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(value), &decoded))
+
+	return decoded
+}
+
+func TestValidateBlockData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		data             string
+		expectError      bool
+		expectWarning    bool
+		expectedContains string
+	}{
+		{
+			name: "valid data",
+			data: `{"bucket_name": "my-bucket", "retries": 3, "storage_class": "STANDARD", "tags": ["a"], "extra_args": {"ACL": "private"}}`,
+		},
+		{
+			name: "optional field set to null",
+			data: `{"bucket_name": "my-bucket", "retries": null}`,
+		},
+		{
+			name: "valid inline nested block",
+			data: `{"bucket_name": "my-bucket", "credentials": {"region_name": "us-east-1"}}`,
+		},
+		{
+			name: "valid block reference",
+			data: `{"bucket_name": "my-bucket", "credentials": {"$ref": {"block_document_id": "cd2cba63-4c8b-4b36-8bf1-a0ce1a1e2fd4"}}}`,
+		},
+		{
+			name:             "missing required field",
+			data:             `{"bucket_folder": "folder"}`,
+			expectError:      true,
+			expectedContains: "`bucket_name`: required field is missing",
+		},
+		{
+			name:             "wrong type",
+			data:             `{"bucket_name": 42}`,
+			expectError:      true,
+			expectedContains: "`bucket_name`: expected string, got integer",
+		},
+		{
+			name:             "wrong type in optional field",
+			data:             `{"bucket_name": "my-bucket", "retries": 1.5}`,
+			expectError:      true,
+			expectedContains: "`retries`: expected integer, got number",
+		},
+		{
+			name:             "wrong type in union",
+			data:             `{"bucket_name": "my-bucket", "endpoint": true}`,
+			expectError:      true,
+			expectedContains: "`endpoint`: value true does not match any of the allowed types",
+		},
+		{
+			name:             "value not in enum",
+			data:             `{"bucket_name": "my-bucket", "storage_class": "COLD"}`,
+			expectError:      true,
+			expectedContains: "`storage_class`: value \"COLD\" is not one of [\"STANDARD\", \"GLACIER\"]",
+		},
+		{
+			name:             "wrong array item type",
+			data:             `{"bucket_name": "my-bucket", "tags": ["a", 1]}`,
+			expectError:      true,
+			expectedContains: "`tags[1]`: expected string, got integer",
+		},
+		{
+			name:             "wrong additional property type",
+			data:             `{"bucket_name": "my-bucket", "extra_args": {"ACL": true}}`,
+			expectError:      true,
+			expectedContains: "`extra_args.ACL`: expected string, got boolean",
+		},
+		{
+			name:             "wrong type in nested block",
+			data:             `{"bucket_name": "my-bucket", "credentials": {"region_name": 1}}`,
+			expectError:      true,
+			expectedContains: "`credentials.region_name`: expected string, got integer",
+		},
+		{
+			name:             "invalid block reference ID",
+			data:             `{"bucket_name": "my-bucket", "credentials": {"$ref": {"block_document_id": "not-a-uuid"}}}`,
+			expectError:      true,
+			expectedContains: "`credentials`: Block reference \"not-a-uuid\" is not a valid Block ID (UUID)",
+		},
+		{
+			name:             "malformed block reference",
+			data:             `{"bucket_name": "my-bucket", "credentials": {"$ref": {"id": "cd2cba63-4c8b-4b36-8bf1-a0ce1a1e2fd4"}}}`,
+			expectError:      true,
+			expectedContains: "`credentials`: Block reference must be in the form",
+		},
+		{
+			name:             "unknown top-level field",
+			data:             `{"bucket_name": "my-bucket", "bucket": "typo"}`,
+			expectWarning:    true,
+			expectedContains: "`bucket`",
+		},
+		{
+			name:             "unknown nested block field",
+			data:             `{"bucket_name": "my-bucket", "credentials": {"region": "us-east-1"}}`,
+			expectWarning:    true,
+			expectedContains: "`credentials.region`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var fields any
+			require.NoError(t, json.Unmarshal([]byte(s3BucketFields), &fields))
+
+			diags := validateBlockData(fields, decodeJSONForTest(t, tt.data), "data")
+
+			assert.Equal(t, tt.expectError, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, tt.expectWarning, diags.WarningsCount() > 0, "unexpected diagnostics: %v", diags)
+
+			if tt.expectedContains != "" {
+				require.NotEmpty(t, diags)
+				assert.Contains(t, diags[0].Detail(), tt.expectedContains)
+			}
+		})
+	}
+}
+
+func TestBlockSecretFields(t *testing.T) {
+	t.Parallel()
+
+	var fields any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"secret_fields": ["password", "connection.token", "service_account_info.*"]
+	}`), &fields))
+
+	tests := []struct {
+		name     string
+		data     string
+		expected []string
+	}{
+		{
+			name:     "no secret fields set",
+			data:     `{"username": "marvin"}`,
+			expected: []string{},
+		},
+		{
+			name:     "top-level secret field",
+			data:     `{"username": "marvin", "password": "hunter2"}`,
+			expected: []string{"password"},
+		},
+		{
+			name:     "nested secret field",
+			data:     `{"connection": {"token": "abc", "host": "localhost"}}`,
+			expected: []string{"connection.token"},
+		},
+		{
+			name:     "wildcard secret field",
+			data:     `{"service_account_info": {"private_key": "abc", "client_email": "marvin@prefect.io"}}`,
+			expected: []string{"service_account_info.client_email", "service_account_info.private_key"},
+		},
+		{
+			name:     "null and referenced values are skipped",
+			data:     `{"password": null, "connection": {"$ref": {"block_document_id": "cd2cba63-4c8b-4b36-8bf1-a0ce1a1e2fd4"}}}`,
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, blockSecretFields(fields, decodeJSONForTest(t, tt.data)))
+		})
+	}
+}
+
+func TestValidateBlockReferences(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		references       []string
+		referencedSlugs  map[string]string
+		expectedContains string
+	}{
+		{
+			name:            "valid reference",
+			references:      []string{"credentials"},
+			referencedSlugs: map[string]string{"credentials": "aws-credentials"},
+		},
+		{
+			name:       "referenced Block type not known yet",
+			references: []string{"credentials"},
+		},
+		{
+			name:             "field is not a Block reference",
+			references:       []string{"bucket_name"},
+			expectedContains: "Field `bucket_name` does not reference a Block",
+		},
+		{
+			name:             "unexpected Block type",
+			references:       []string{"credentials"},
+			referencedSlugs:  map[string]string{"credentials": "secret"},
+			expectedContains: "references a Block of type `secret`, expected `aws-credentials`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var fields any
+			require.NoError(t, json.Unmarshal([]byte(s3BucketFields), &fields))
+
+			diags := validateBlockReferences(fields, tt.references, tt.referencedSlugs)
+
+			if tt.expectedContains == "" {
+				assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+				return
+			}
+
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags[0].Detail(), tt.expectedContains)
+		})
+	}
+}
+
+func TestBlockSchemaReferenceSlugs(t *testing.T) {
+	t.Parallel()
+
+	fields := decodeJSONForTest(t, `{
+		"block_schema_references": {
+			"credentials": {"block_type_slug": "aws-credentials", "block_schema_checksum": "sha256:abc"},
+			"storage": [
+				{"block_type_slug": "s3-bucket", "block_schema_checksum": "sha256:def"},
+				{"block_type_slug": "gcs-bucket", "block_schema_checksum": "sha256:ghi"}
+			]
+		}
+	}`)
+
+	assert.Equal(t, map[string][]string{
+		"credentials": {"aws-credentials"},
+		"storage":     {"gcs-bucket", "s3-bucket"},
+	}, blockSchemaReferenceSlugs(fields))
+}
+
+func TestBlockDataWithReferences(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	credentialsID := uuid.New()
+
+	references, diags := types.MapValueFrom(ctx, customtypes.UUIDType{}, map[string]customtypes.UUIDValue{
+		"credentials": customtypes.NewUUIDValue(credentialsID),
+	})
+	require.False(t, diags.HasError())
+
+	data := map[string]any{"bucket_name": "my-bucket"}
+
+	payload, diags := blockDataWithReferences(ctx, data, references)
+	require.False(t, diags.HasError())
+	assert.Equal(t, map[string]any{
+		"bucket_name": "my-bucket",
+		"credentials": map[string]any{"$ref": map[string]any{"block_document_id": credentialsID.String()}},
+	}, payload)
+	assert.NotContains(t, data, "credentials", "the data must not be modified")
+
+	payload, diags = blockDataWithReferences(ctx, data, types.MapNull(customtypes.UUIDType{}))
+	require.False(t, diags.HasError())
+	assert.Equal(t, data, payload)
+
+	_, diags = blockDataWithReferences(ctx, map[string]any{"credentials": map[string]any{}}, references)
+	assert.True(t, diags.HasError())
+}
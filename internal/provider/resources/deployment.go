@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -34,6 +36,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&DeploymentResource{})
 	_ = resource.ResourceWithImportState(&DeploymentResource{})
+	_ = resource.ResourceWithModifyPlan(&DeploymentResource{})
 )
 
 // DeploymentResource contains state for the resource.
@@ -48,25 +51,37 @@ type DeploymentResourceModel struct {
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 
-	ConcurrencyLimit         types.Int64           `tfsdk:"concurrency_limit"`
-	ConcurrencyOptions       *ConcurrencyOptions   `tfsdk:"concurrency_options"`
-	Description              types.String          `tfsdk:"description"`
-	EnforceParameterSchema   types.Bool            `tfsdk:"enforce_parameter_schema"`
-	Entrypoint               types.String          `tfsdk:"entrypoint"`
-	FlowID                   customtypes.UUIDValue `tfsdk:"flow_id"`
-	GlobalConcurrencyLimitID customtypes.UUIDValue `tfsdk:"global_concurrency_limit_id"`
-	JobVariables             jsontypes.Normalized  `tfsdk:"job_variables"`
-	Name                     types.String          `tfsdk:"name"`
-	ParameterOpenAPISchema   jsontypes.Normalized  `tfsdk:"parameter_openapi_schema"`
-	Parameters               jsontypes.Normalized  `tfsdk:"parameters"`
-	Path                     types.String          `tfsdk:"path"`
-	Paused                   types.Bool            `tfsdk:"paused"`
-	PullSteps                []PullStepModel       `tfsdk:"pull_steps"`
-	StorageDocumentID        customtypes.UUIDValue `tfsdk:"storage_document_id"`
-	Tags                     types.Set             `tfsdk:"tags"`
-	Version                  types.String          `tfsdk:"version"`
-	WorkPoolName             types.String          `tfsdk:"work_pool_name"`
-	WorkQueueName            types.String          `tfsdk:"work_queue_name"`
+	ConcurrencyLimit         types.Int64                   `tfsdk:"concurrency_limit"`
+	ConcurrencyOptions       *ConcurrencyOptions           `tfsdk:"concurrency_options"`
+	Description              types.String                  `tfsdk:"description"`
+	EnforceParameterSchema   types.Bool                    `tfsdk:"enforce_parameter_schema"`
+	Entrypoint               types.String                  `tfsdk:"entrypoint"`
+	FlowID                   customtypes.UUIDValue         `tfsdk:"flow_id"`
+	GlobalConcurrencyLimitID customtypes.UUIDValue         `tfsdk:"global_concurrency_limit_id"`
+	JobVariables             customtypes.JobVariablesValue `tfsdk:"job_variables"`
+	Name                     types.String                  `tfsdk:"name"`
+	ParameterOpenAPISchema   jsontypes.Normalized          `tfsdk:"parameter_openapi_schema"`
+	Parameters               jsontypes.Normalized          `tfsdk:"parameters"`
+	Path                     types.String                  `tfsdk:"path"`
+	Paused                   types.Bool                    `tfsdk:"paused"`
+	PullSteps                []PullStepModel               `tfsdk:"pull_steps"`
+	StorageDocumentID        customtypes.UUIDValue         `tfsdk:"storage_document_id"`
+	Tags                     types.Set                     `tfsdk:"tags"`
+	Version                  types.String                  `tfsdk:"version"`
+	WorkPoolName             types.String                  `tfsdk:"work_pool_name"`
+	WorkQueueName            types.String                  `tfsdk:"work_queue_name"`
+}
+
+// deploymentResourceModelWithTimeouts adds the resource-only `schedules` attribute
+// and `timeouts` block to DeploymentResourceModel, which is shared with the
+// deployment data source.
+type deploymentResourceModelWithTimeouts struct {
+	DeploymentResourceModel
+
+	// Schedules is a list of DeploymentInlineScheduleModel.
+	Schedules types.List `tfsdk:"schedules"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ConcurrentOptions represents the concurrency options for a deployment.
@@ -179,7 +194,7 @@ func (r *DeploymentResource) Configure(_ context.Context, req resource.Configure
 
 // Schema defines the schema for the resource.
 // nolint:maintidx,gocyclo // this schema is complex, and we can refactor it later
-func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyTagSet, _ := basetypes.NewSetValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
@@ -265,10 +280,10 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "ID of the associated storage document (UUID)",
 			},
 			"job_variables": schema.StringAttribute{
-				Description: "Overrides for the flow's infrastructure configuration.",
+				Description: "Overrides for the flow's infrastructure configuration. `null` values, whitespace inside `{{ }}` placeholders and job variables added by the server with the work pool's default value do not show up as changes.",
 				Optional:    true,
 				Computed:    true,
-				CustomType:  jsontypes.NormalizedType{},
+				CustomType:  customtypes.JobVariablesType{},
 				Default:     stringdefault.StaticString("{}"),
 			},
 			"work_queue_name": schema.StringAttribute{
@@ -494,7 +509,92 @@ func (r *DeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"schedules": deploymentInlineSchedulesAttribute(),
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// ModifyPlan validates the inline schedules, and rejects the plan when the
+// deployment has schedules that it did not create and does not declare.
+func (r *DeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var schedulesList types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schedules"), &schedulesList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, diags := inlineDeploymentSchedulesFromList(ctx, schedulesList)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateInlineDeploymentScheduleSlugs(schedules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The deployment does not exist yet, or does not manage its schedules.
+	if req.State.Raw.IsNull() || schedulesList.IsNull() || schedulesList.IsUnknown() || r.client == nil {
+		return
+	}
+
+	// The deployment is being replaced, and the new one starts with the planned schedules only.
+	var id customtypes.UUIDValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() || id.IsUnknown() {
+		return
+	}
+
+	var state deploymentResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorSchedules, diags := inlineDeploymentSchedulesFromList(ctx, state.Schedules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.client.DeploymentSchedule(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
+
+		return
+	}
+
+	// Schedules created elsewhere, for example by prefect_deployment_schedule,
+	// would be deleted on apply and recreated by their own resource on the next one.
+	unmanaged, err := unmanagedInlineDeploymentSchedules(ctx, client, state.ID.ValueUUID(), schedules, priorSchedules)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", "read", err))
+
+		return
+	}
+
+	if len(unmanaged) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedules"),
+			"Conflicting deployment schedules",
+			fmt.Sprintf(
+				"Deployment %s has schedules that it did not create and that are not declared in its `schedules` attribute: %s. "+
+					"If they are managed by `prefect_deployment_schedule` or `prefect_deployment_schedules`, remove the `schedules` attribute "+
+					"to stop managing schedules inline, or move the schedules to the `schedules` attribute. "+
+					"Otherwise, delete them, or declare them by slug to adopt them.",
+				state.ID.ValueString(),
+				strings.Join(unmanaged, ", "),
+			),
+		)
 	}
 }
 
@@ -768,7 +868,7 @@ func CopyDeploymentToModel(ctx context.Context, deployment *api.Deployment, mode
 	if err != nil {
 		return diag.Diagnostics{helpers.SerializeDataErrorDiagnostic("job_variables", "Deployment job variables", err)}
 	}
-	model.JobVariables = jsontypes.NewNormalizedValue(string(jobVariablesByteSlice))
+	model.JobVariables = customtypes.NewJobVariablesValue(string(jobVariablesByteSlice))
 
 	parameterOpenAPISchemaByteSlice, err := json.Marshal(deployment.ParameterOpenAPISchema)
 	if err != nil {
@@ -798,7 +898,7 @@ func CopyDeploymentToModel(ctx context.Context, deployment *api.Deployment, mode
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan DeploymentResourceModel
+	var plan deploymentResourceModelWithTimeouts
 
 	// Populate the model from resource configuration and emit diagnostics on error
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -806,6 +906,15 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.Deployments(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -828,7 +937,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	jobVariables, diags := helpers.UnmarshalOptional(plan.JobVariables)
+	jobVariables, diags := helpers.UnmarshalOptional(plan.JobVariables.Normalized)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -846,6 +955,18 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	plannedSchedules, diags := inlineDeploymentSchedulesFromList(ctx, plan.Schedules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, diags := inlineDeploymentSchedulesCreatePayload(plannedSchedules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createPayload := api.DeploymentCreate{
 		ConcurrencyLimit:         plan.ConcurrencyLimit.ValueInt64Pointer(),
 		Description:              plan.Description.ValueString(),
@@ -859,6 +980,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		Path:                     plan.Path.ValueString(),
 		Paused:                   plan.Paused.ValueBool(),
 		PullSteps:                pullSteps,
+		Schedules:                schedules,
 		StorageDocumentID:        plan.StorageDocumentID.ValueUUIDPointer(),
 		Tags:                     tags,
 		Version:                  plan.Version.ValueString(),
@@ -878,6 +1000,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 	// typed parameters, which would cause "inconsistent result after apply"
 	// if we stored the server's value when the plan said "{}".
 	plannedOpenAPISchema := plan.ParameterOpenAPISchema
+	plannedJobVariables := plan.JobVariables
 
 	deployment, err := client.Create(ctx, createPayload)
 	if err != nil {
@@ -889,11 +1012,13 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	resp.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &plan)...)
+	resp.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &plan.DeploymentResourceModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.removeServerAddedJobVariables(ctx, &plan.DeploymentResourceModel, plannedJobVariables)
+
 	// If the user's planned value was an empty JSON object, preserve it
 	// rather than storing the server-enriched schema. This prevents a
 	// taint loop when the server auto-populates the schema from the flow.
@@ -901,6 +1026,13 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		plan.ParameterOpenAPISchema = plannedOpenAPISchema
 	}
 
+	if !plan.Schedules.IsNull() {
+		resp.Diagnostics.Append(r.readInlineSchedules(ctx, &plan, plannedSchedules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -909,7 +1041,7 @@ func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var model DeploymentResourceModel
+	var model deploymentResourceModelWithTimeouts
 
 	// Populate the model from state and emit diagnostics on error
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
@@ -917,6 +1049,15 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, timeoutDiags := model.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.Deployments(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -964,11 +1105,28 @@ func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &model)...)
+	priorJobVariables := model.JobVariables
+
+	resp.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &model.DeploymentResourceModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.removeServerAddedJobVariables(ctx, &model.DeploymentResourceModel, priorJobVariables)
+
+	if !model.Schedules.IsNull() {
+		priorSchedules, diags := inlineDeploymentSchedulesFromList(ctx, model.Schedules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.readInlineSchedules(ctx, &model, priorSchedules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1033,18 +1191,27 @@ func concurrencyOptionsUpdateValue(plan, prior *ConcurrencyOptions) json.RawMess
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model DeploymentResourceModel
+	var model deploymentResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, timeoutDiags := model.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// We need the prior state to know whether a concurrency limit is being
 	// cleared. The Prefect API only clears a limit when it receives an explicit
 	// null, and routes both concurrency_limit and global_concurrency_limit_id
 	// through the same underlying limit, so we send each only when it changes.
-	var priorState DeploymentResourceModel
+	var priorState deploymentResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1083,7 +1250,7 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	jobVariables, diags := helpers.UnmarshalOptional(model.JobVariables)
+	jobVariables, diags := helpers.UnmarshalOptional(model.JobVariables.Normalized)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1125,6 +1292,7 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Capture the planned parameter_openapi_schema before the API call
 	// overwrites it (same reason as in Create).
 	plannedOpenAPISchema := model.ParameterOpenAPISchema
+	plannedJobVariables := model.JobVariables
 
 	err = client.Update(ctx, deploymentID, payload)
 
@@ -1137,6 +1305,37 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Inline schedules are not part of the deployment update payload,
+	// so they are reconciled one by one against the deployment's schedules.
+	var plannedSchedules []DeploymentInlineScheduleModel
+	if !model.Schedules.IsNull() {
+		plannedSchedules, diags = inlineDeploymentSchedulesFromList(ctx, model.Schedules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var scheduleClient api.DeploymentScheduleClient
+		scheduleClient, err = r.client.DeploymentSchedule(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+		if err != nil {
+			resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
+
+			return
+		}
+
+		var priorSchedules []DeploymentInlineScheduleModel
+		priorSchedules, diags = inlineDeploymentSchedulesFromList(ctx, priorState.Schedules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(reconcileInlineDeploymentSchedules(ctx, scheduleClient, deploymentID, plannedSchedules, priorSchedules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	deployment, err := client.Get(ctx, deploymentID)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1147,15 +1346,24 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	resp.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &model)...)
+	resp.Diagnostics.Append(CopyDeploymentToModel(ctx, deployment, &model.DeploymentResourceModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.removeServerAddedJobVariables(ctx, &model.DeploymentResourceModel, plannedJobVariables)
+
 	if helpers.IsEmptyJSONObject(plannedOpenAPISchema.ValueString()) {
 		model.ParameterOpenAPISchema = plannedOpenAPISchema
 	}
 
+	if !model.Schedules.IsNull() {
+		resp.Diagnostics.Append(r.readInlineSchedules(ctx, &model, plannedSchedules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1164,13 +1372,22 @@ func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state DeploymentResourceModel
+	var state deploymentResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.Deployments(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1208,6 +1425,33 @@ func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// readInlineSchedules reads the deployment's schedules into the `schedules`
+// attribute of the model. See readInlineDeploymentSchedules for the meaning of prior.
+func (r *DeploymentResource) readInlineSchedules(
+	ctx context.Context,
+	model *deploymentResourceModelWithTimeouts,
+	prior []DeploymentInlineScheduleModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := r.client.DeploymentSchedule(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
+
+		return diags
+	}
+
+	schedules, readDiags := readInlineDeploymentSchedules(ctx, client, model.ID.ValueUUID(), prior)
+	diags.Append(readDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Schedules = schedules
+
+	return diags
+}
+
 // ImportState imports the resource into Terraform state.
 func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStateByID(ctx, req, resp)
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,6 +35,8 @@ type DeploymentAccessResourceModel struct {
 	ManageTeamIDs  types.List `tfsdk:"manage_team_ids"`
 	RunTeamIDs     types.List `tfsdk:"run_team_ids"`
 	ViewTeamIDs    types.List `tfsdk:"view_team_ids"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewDeploymentAccessResource returns a new DeploymentAccessResource.
@@ -64,7 +67,7 @@ func (r *DeploymentAccessResource) Configure(_ context.Context, req resource.Con
 	r.client = client
 }
 
-func (r *DeploymentAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DeploymentAccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	defaultEmptyList, _ := basetypes.NewListValue(types.StringType, []attr.Value{})
 
 	resp.Schema = schema.Schema{
@@ -142,6 +145,9 @@ func (r *DeploymentAccessResource) Schema(_ context.Context, _ resource.SchemaRe
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -155,6 +161,15 @@ func (r *DeploymentAccessResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentAccess(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Access", err))
@@ -228,6 +243,15 @@ func (r *DeploymentAccessResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentAccess(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Access", err))
@@ -269,6 +293,15 @@ func (r *DeploymentAccessResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentAccess(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Access", err))
@@ -342,6 +375,15 @@ func (r *DeploymentAccessResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentAccess(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Access", err))
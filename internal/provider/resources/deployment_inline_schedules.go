@@ -0,0 +1,338 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// DeploymentInlineScheduleModel represents a schedule declared inline
+// in the `schedules` attribute of a deployment.
+type DeploymentInlineScheduleModel struct {
+	DeploymentSchedulesScheduleModel
+
+	Slug types.String `tfsdk:"slug"`
+}
+
+// deploymentInlineScheduleAttrTypes are the attribute types of
+// a single element in the deployment's `schedules` list.
+var deploymentInlineScheduleAttrTypes = func() map[string]attr.Type {
+	attrTypes := maps.Clone(deploymentSchedulesScheduleAttrTypes)
+	attrTypes["slug"] = types.StringType
+
+	return attrTypes
+}()
+
+// deploymentInlineSchedulesAttribute returns the schema for the
+// deployment's `schedules` attribute.
+func deploymentInlineSchedulesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Schedules for the deployment, equivalent to the `schedules` section of `prefect.yaml`. " +
+			"When set, the deployment owns its entire schedule set: schedules removed from this list are deleted, and plans are rejected " +
+			"while the deployment has schedules that it did not create and that are not listed here, such as those created by " +
+			"`prefect_deployment_schedule`, `prefect_deployment_schedules`, `prefect deploy` or the UI. " +
+			"Listing such a schedule by its slug adopts it. " +
+			"When omitted, the deployment's schedules are not managed by this resource.",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					CustomType:  customtypes.UUIDType{},
+					Description: "Deployment Schedule ID (UUID)",
+				},
+				"slug": schema.StringAttribute{
+					Description: "A unique identifier for the schedule within the deployment.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"active": schema.BoolAttribute{
+					Description: "Whether or not the schedule is active.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"max_scheduled_runs": schema.Float32Attribute{
+					Description: "The maximum number of scheduled runs for the schedule.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"parameters": schema.StringAttribute{
+					Description: "Parameters for flow runs scheduled by the deployment schedule.",
+					Optional:    true,
+					CustomType:  jsontypes.NormalizedType{},
+					Computed:    true,
+					Default:     stringdefault.StaticString("{}"),
+				},
+				// Timezone is a common field for all schedule kinds.
+				"timezone": schema.StringAttribute{
+					Description: "The timezone of the schedule.",
+					Optional:    true,
+					Computed:    true,
+				},
+				// Schedule kind: interval
+				"interval": schema.Float32Attribute{
+					Description: "The interval of the schedule.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"anchor_date": schema.StringAttribute{
+					Description: "The anchor date of the schedule.",
+					Optional:    true,
+					Computed:    true,
+				},
+				// Schedule kind: cron
+				"cron": schema.StringAttribute{
+					Description: "The cron expression of the schedule.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"day_or": schema.BoolAttribute{
+					Description: "Control croniter behavior for handling day and day_of_week entries.",
+					Optional:    true,
+					Computed:    true,
+				},
+				// Schedule kind: rrule
+				"rrule": schema.StringAttribute{
+					Description: "The rrule expression of the schedule.",
+					Optional:    true,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// inlineDeploymentSchedulesFromList returns the schedules in the deployment's
+// `schedules` list. A null or unknown list returns no schedules.
+func inlineDeploymentSchedulesFromList(ctx context.Context, list types.List) ([]DeploymentInlineScheduleModel, diag.Diagnostics) {
+	schedules := []DeploymentInlineScheduleModel{}
+	if list.IsNull() || list.IsUnknown() {
+		return schedules, nil
+	}
+
+	diags := list.ElementsAs(ctx, &schedules, false)
+
+	return schedules, diags
+}
+
+// inlineDeploymentSchedulesBySlug keys the inline schedules by slug.
+func inlineDeploymentSchedulesBySlug(schedules []DeploymentInlineScheduleModel) map[string]DeploymentSchedulesScheduleModel {
+	bySlug := make(map[string]DeploymentSchedulesScheduleModel, len(schedules))
+	for i := range schedules {
+		bySlug[schedules[i].Slug.ValueString()] = schedules[i].DeploymentSchedulesScheduleModel
+	}
+
+	return bySlug
+}
+
+// validateInlineDeploymentScheduleSlugs ensures that no two inline schedules share a slug.
+func validateInlineDeploymentScheduleSlugs(schedules []DeploymentInlineScheduleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]bool, len(schedules))
+	for i := range schedules {
+		if schedules[i].Slug.IsUnknown() || schedules[i].Slug.IsNull() {
+			continue
+		}
+
+		slug := schedules[i].Slug.ValueString()
+		if seen[slug] {
+			diags.AddAttributeError(
+				path.Root("schedules").AtListIndex(i).AtName("slug"),
+				"Duplicate schedule slug",
+				fmt.Sprintf("The slug %q is used by more than one schedule. Each schedule of a deployment must have a unique slug.", slug),
+			)
+		}
+
+		seen[slug] = true
+	}
+
+	return diags
+}
+
+// inlineDeploymentSchedulePayloads builds the API payloads for the inline schedules, keyed by slug.
+func inlineDeploymentSchedulePayloads(schedules []DeploymentInlineScheduleModel) (map[string]api.DeploymentSchedulePayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payloads := make(map[string]api.DeploymentSchedulePayload, len(schedules))
+	for i := range schedules {
+		slug := schedules[i].Slug.ValueString()
+
+		payload, payloadDiags := deploymentSchedulePayloadFromModel(slug, schedules[i].DeploymentSchedulesScheduleModel)
+		diags.Append(payloadDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		payloads[slug] = payload
+	}
+
+	return payloads, diags
+}
+
+// inlineDeploymentSchedulesCreatePayload returns the inline schedules as a list
+// of payloads for DeploymentCreate, in the order they are declared.
+func inlineDeploymentSchedulesCreatePayload(schedules []DeploymentInlineScheduleModel) ([]api.DeploymentSchedulePayload, diag.Diagnostics) {
+	payloads, diags := inlineDeploymentSchedulePayloads(schedules)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ordered := make([]api.DeploymentSchedulePayload, 0, len(schedules))
+	for i := range schedules {
+		ordered = append(ordered, payloads[schedules[i].Slug.ValueString()])
+	}
+
+	return ordered, diags
+}
+
+// reconcileInlineDeploymentSchedules applies the minimal set of changes to
+// make the deployment's schedules match the planned inline schedules.
+//
+// Only the schedules known when planning, which are the planned schedules and
+// those in prior state, are deleted. Schedules created since the last refresh,
+// for example by prefect_deployment_schedule, are left untouched and reported,
+// as the plan did not show their removal. prior is nil on creation.
+func reconcileInlineDeploymentSchedules(
+	ctx context.Context,
+	client api.DeploymentScheduleClient,
+	deploymentID uuid.UUID,
+	planned []DeploymentInlineScheduleModel,
+	prior []DeploymentInlineScheduleModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	desired, payloadDiags := inlineDeploymentSchedulePayloads(planned)
+	diags.Append(payloadDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	known := inlineDeploymentSchedulesBySlug(prior)
+	maps.Copy(known, inlineDeploymentSchedulesBySlug(planned))
+
+	unmanaged, err := reconcileDeploymentSchedules(ctx, client, deploymentID, desired, known)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", "update", err))
+
+		return diags
+	}
+
+	if len(unmanaged) > 0 {
+		diags.AddWarning(
+			"Unmanaged deployment schedules",
+			fmt.Sprintf(
+				"Deployment %s has schedules that are not declared in its `schedules` attribute: %s. "+
+					"They were created since the last refresh, and are left untouched. Until they are deleted, "+
+					"or declared by slug to adopt them, plans for the deployment are rejected.",
+				deploymentID,
+				strings.Join(unmanaged, ", "),
+			),
+		)
+	}
+
+	return diags
+}
+
+// unmanagedInlineDeploymentSchedules returns the keys of the deployment's
+// schedules that are neither in prior state nor planned, which are the
+// schedules that the deployment did not create and does not declare.
+func unmanagedInlineDeploymentSchedules(
+	ctx context.Context,
+	client api.DeploymentScheduleClient,
+	deploymentID uuid.UUID,
+	planned []DeploymentInlineScheduleModel,
+	prior []DeploymentInlineScheduleModel,
+) ([]string, error) {
+	schedules, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedules: %w", err)
+	}
+
+	known := inlineDeploymentSchedulesBySlug(prior)
+	maps.Copy(known, inlineDeploymentSchedulesBySlug(planned))
+
+	return unmanagedDeploymentScheduleKeys(keyDeploymentSchedules(schedules, known), known), nil
+}
+
+// readInlineDeploymentSchedules reads the deployment's schedules into a value
+// for the `schedules` list.
+//
+// Only the schedules in prior, which is the plan after Create/Update and
+// the state on Read, are read, in the same order. Other schedules are not
+// managed by the deployment, and are left out.
+func readInlineDeploymentSchedules(
+	ctx context.Context,
+	client api.DeploymentScheduleClient,
+	deploymentID uuid.UUID,
+	prior []DeploymentInlineScheduleModel,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectType := types.ObjectType{AttrTypes: deploymentInlineScheduleAttrTypes}
+
+	schedules, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", "read", err))
+
+		return types.ListNull(objectType), diags
+	}
+
+	known := inlineDeploymentSchedulesBySlug(prior)
+	existing := keyDeploymentSchedules(schedules, known)
+
+	keys := make([]string, 0, len(existing))
+	for i := range prior {
+		if _, ok := existing[prior[i].Slug.ValueString()]; ok {
+			keys = append(keys, prior[i].Slug.ValueString())
+		}
+	}
+
+	elements := make([]DeploymentInlineScheduleModel, 0, len(keys))
+	for _, key := range keys {
+		schedule := existing[key]
+
+		element, elementDiags := deploymentSchedulesScheduleModelFromAPI(schedule, known[key].RRule.ValueString())
+		diags.Append(elementDiags...)
+		if diags.HasError() {
+			return types.ListNull(objectType), diags
+		}
+
+		// The slug is the key, which falls back to the known slug when
+		// the server does not echo it (see keyDeploymentSchedules).
+		slug := schedule.Slug
+		if slug == "" {
+			if _, ok := known[key]; ok {
+				slug = key
+			}
+		}
+
+		elements = append(elements, DeploymentInlineScheduleModel{
+			DeploymentSchedulesScheduleModel: element,
+			Slug:                             types.StringValue(slug),
+		})
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, objectType, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
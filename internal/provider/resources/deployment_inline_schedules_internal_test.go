@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateInlineDeploymentScheduleSlugs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		slugs     []string
+		wantError bool
+	}{
+		{name: "no schedules", slugs: []string{}, wantError: false},
+		{name: "unique slugs", slugs: []string{"hourly", "daily"}, wantError: false},
+		{name: "duplicate slugs", slugs: []string{"hourly", "daily", "hourly"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedules := make([]DeploymentInlineScheduleModel, 0, len(tt.slugs))
+			for _, slug := range tt.slugs {
+				schedules = append(schedules, DeploymentInlineScheduleModel{Slug: types.StringValue(slug)})
+			}
+
+			diags := validateInlineDeploymentScheduleSlugs(schedules)
+			assert.Equal(t, tt.wantError, diags.HasError())
+		})
+	}
+}
+
+// fakeDeploymentScheduleClient serves a fixed set of schedules,
+// and records the schedules deleted through it.
+type fakeDeploymentScheduleClient struct {
+	schedules []*api.DeploymentSchedule
+	deleted   []uuid.UUID
+}
+
+func (c *fakeDeploymentScheduleClient) Create(_ context.Context, _ uuid.UUID, _ []api.DeploymentSchedulePayload) ([]*api.DeploymentSchedule, error) {
+	return nil, nil
+}
+
+func (c *fakeDeploymentScheduleClient) Read(_ context.Context, _ uuid.UUID) ([]*api.DeploymentSchedule, error) {
+	return c.schedules, nil
+}
+
+func (c *fakeDeploymentScheduleClient) Update(_ context.Context, _, _ uuid.UUID, _ api.DeploymentSchedulePayload) error {
+	return nil
+}
+
+func (c *fakeDeploymentScheduleClient) Delete(_ context.Context, _, scheduleID uuid.UUID) error {
+	c.deleted = append(c.deleted, scheduleID)
+
+	return nil
+}
+
+func TestReconcileInlineDeploymentSchedulesUnmanaged(t *testing.T) {
+	t.Parallel()
+
+	hourlyID := uuid.New()
+	nightlyID := uuid.New()
+	standaloneID := uuid.New()
+
+	newSchedule := func(id uuid.UUID, slug string) *api.DeploymentSchedule {
+		return &api.DeploymentSchedule{
+			BaseModel:                 api.BaseModel{ID: id},
+			DeploymentSchedulePayload: api.DeploymentSchedulePayload{Slug: slug, Schedule: api.Schedule{Cron: "0 0 * * *"}},
+		}
+	}
+
+	newModel := func(id uuid.UUID, slug string) DeploymentInlineScheduleModel {
+		return DeploymentInlineScheduleModel{
+			DeploymentSchedulesScheduleModel: DeploymentSchedulesScheduleModel{
+				ID:         customtypes.NewUUIDValue(id),
+				Cron:       types.StringValue("0 0 * * *"),
+				Parameters: jsontypes.NewNormalizedValue("{}"),
+			},
+			Slug: types.StringValue(slug),
+		}
+	}
+
+	client := &fakeDeploymentScheduleClient{
+		schedules: []*api.DeploymentSchedule{
+			newSchedule(hourlyID, "hourly"),
+			newSchedule(nightlyID, "nightly"),
+			// Created by prefect_deployment_schedule since the last refresh.
+			newSchedule(standaloneID, "standalone"),
+		},
+	}
+
+	planned := []DeploymentInlineScheduleModel{newModel(hourlyID, "hourly")}
+	prior := []DeploymentInlineScheduleModel{newModel(hourlyID, "hourly"), newModel(nightlyID, "nightly")}
+
+	diags := reconcileInlineDeploymentSchedules(context.Background(), client, uuid.New(), planned, prior)
+	require.False(t, diags.HasError())
+
+	// Only the schedule removed from the plan is deleted, the unknown one is reported.
+	assert.Equal(t, []uuid.UUID{nightlyID}, client.deleted)
+	require.Equal(t, 1, diags.WarningsCount())
+	assert.Contains(t, diags.Warnings()[0].Detail(), "standalone")
+}
+
+func TestUnmanagedInlineDeploymentSchedules(t *testing.T) {
+	t.Parallel()
+
+	hourlyID := uuid.New()
+	adoptedID := uuid.New()
+	standaloneID := uuid.New()
+
+	client := &fakeDeploymentScheduleClient{
+		schedules: []*api.DeploymentSchedule{
+			{BaseModel: api.BaseModel{ID: hourlyID}, DeploymentSchedulePayload: api.DeploymentSchedulePayload{Slug: "hourly"}},
+			// Created through the UI, and declared in the plan to adopt it.
+			{BaseModel: api.BaseModel{ID: adoptedID}, DeploymentSchedulePayload: api.DeploymentSchedulePayload{Slug: "adopted"}},
+			// Created by prefect_deployment_schedule, without a slug.
+			{BaseModel: api.BaseModel{ID: standaloneID}},
+		},
+	}
+
+	newModel := func(slug string) DeploymentInlineScheduleModel {
+		return DeploymentInlineScheduleModel{Slug: types.StringValue(slug)}
+	}
+
+	prior := []DeploymentInlineScheduleModel{newModel("hourly")}
+	planned := []DeploymentInlineScheduleModel{newModel("hourly"), newModel("adopted")}
+
+	unmanaged, err := unmanagedInlineDeploymentSchedules(context.Background(), client, uuid.New(), planned, prior)
+	require.NoError(t, err)
+	assert.Equal(t, []string{standaloneID.String()}, unmanaged)
+}
@@ -0,0 +1,98 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+)
+
+// removeServerAddedJobVariables removes the job variables that the server added to the
+// deployment with the default value declared by the work pool's variables schema, so that
+// job variables that are not configured do not show up as changes in plans.
+//
+// The work pool is only fetched when the server returned job variables that are not in the
+// prior value. Failing to fetch it is not an error: the job variables are left as-is.
+func (r *DeploymentResource) removeServerAddedJobVariables(ctx context.Context, model *DeploymentResourceModel, prior customtypes.JobVariablesValue) {
+	if model.JobVariables.IsNull() || model.WorkPoolName.ValueString() == "" {
+		return
+	}
+
+	priorJobVariables := map[string]any{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if diags := prior.Unmarshal(&priorJobVariables); diags.HasError() {
+			return
+		}
+	}
+
+	var jobVariables map[string]any
+	if diags := model.JobVariables.Unmarshal(&jobVariables); diags.HasError() {
+		return
+	}
+
+	hasAddedJobVariables := false
+	for name := range jobVariables {
+		if _, ok := priorJobVariables[name]; !ok {
+			hasAddedJobVariables = true
+
+			break
+		}
+	}
+
+	if !hasAddedJobVariables {
+		return
+	}
+
+	client, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		tflog.Debug(ctx, "Could not create work pool client to compare job variables", map[string]any{"error": err.Error()})
+
+		return
+	}
+
+	pool, err := client.Get(ctx, model.WorkPoolName.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Could not read work pool to compare job variables", map[string]any{"error": err.Error()})
+
+		return
+	}
+
+	if !removeJobVariableDefaults(priorJobVariables, jobVariables, pool.BaseJobTemplate) {
+		return
+	}
+
+	byteSlice, err := json.Marshal(jobVariables)
+	if err != nil {
+		return
+	}
+
+	model.JobVariables = customtypes.NewJobVariablesValue(string(byteSlice))
+}
+
+// removeJobVariableDefaults removes the job variables that are not in the prior job variables
+// and are set to the default value declared by the base job template's variables schema.
+// It returns true if any job variable was removed.
+func removeJobVariableDefaults(prior, jobVariables, baseJobTemplate map[string]any) bool {
+	variables, _ := baseJobTemplate["variables"].(map[string]any)
+	properties, _ := variables["properties"].(map[string]any)
+
+	removed := false
+	for name, value := range jobVariables {
+		if _, ok := prior[name]; ok {
+			continue
+		}
+
+		property, _ := properties[name].(map[string]any)
+		defaultValue, ok := property["default"]
+		if !ok || !reflect.DeepEqual(defaultValue, value) {
+			continue
+		}
+
+		delete(jobVariables, name)
+		removed = true
+	}
+
+	return removed
+}
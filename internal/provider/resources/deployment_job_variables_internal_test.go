@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveJobVariableDefaults(t *testing.T) {
+	t.Parallel()
+
+	baseJobTemplate := map[string]any{
+		"variables": map[string]any{
+			"properties": map[string]any{
+				"image":         map[string]any{"type": "string", "default": "prefecthq/prefect:3-latest"},
+				"stream_output": map[string]any{"type": "boolean", "default": true},
+				"env":           map[string]any{"type": "object"},
+			},
+		},
+	}
+
+	prior := map[string]any{
+		"image": "prefecthq/prefect:3-latest",
+	}
+
+	jobVariables := map[string]any{
+		// Configured, so kept even though it is set to the default value.
+		"image": "prefecthq/prefect:3-latest",
+		// Added by the server with the default value.
+		"stream_output": true,
+		// Added by the server, but there is no default value.
+		"env": map[string]any{},
+	}
+
+	removed := removeJobVariableDefaults(prior, jobVariables, baseJobTemplate)
+
+	assert.True(t, removed)
+	assert.Equal(t, map[string]any{
+		"image": "prefecthq/prefect:3-latest",
+		"env":   map[string]any{},
+	}, jobVariables)
+
+	assert.False(t, removeJobVariableDefaults(prior, jobVariables, baseJobTemplate))
+	assert.False(t, removeJobVariableDefaults(map[string]any{}, map[string]any{"cpu": 1.0}, nil))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -57,6 +58,8 @@ type DeploymentScheduleResourceModel struct {
 	// Schedule parameters and metadata
 	Parameters jsontypes.Normalized `tfsdk:"parameters"`
 	Slug       types.String         `tfsdk:"slug"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewDeploymentScheduleResource returns a new DeploymentScheduleResource.
@@ -87,11 +90,15 @@ func (r *DeploymentScheduleResource) Configure(_ context.Context, req resource.C
 	r.client = client
 }
 
-func (r *DeploymentScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DeploymentScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(`
 The resource 'deployment_schedule' represents a schedule for a deployment.
 <br>
+Do not use this resource for a deployment that declares its schedules in the `+"`schedules`"+` attribute
+of `+"`prefect_deployment`"+`. Once the schedule is created, the deployment rejects its plans until
+either this resource or the `+"`schedules`"+` attribute is removed.
+<br>
 For more information, see [schedule flow runs](https://docs.prefect.io/v3/automate/add-schedules).
 `,
 			helpers.AllPlans...,
@@ -198,6 +205,9 @@ For more information, see [schedule flow runs](https://docs.prefect.io/v3/automa
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -211,6 +221,15 @@ func (r *DeploymentScheduleResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentSchedule(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
@@ -233,7 +252,7 @@ func (r *DeploymentScheduleResource) Create(ctx context.Context, req resource.Cr
 			Schedule: api.Schedule{
 				AnchorDate: plan.AnchorDate.ValueString(),
 				Cron:       plan.Cron.ValueString(),
-				DayOr:      plan.DayOr.ValueBool(),
+				DayOr:      dayOrPayload(plan.DayOr),
 				Interval:   plan.Interval.ValueFloat32(),
 				RRule:      plan.RRule.ValueString(),
 				Timezone:   plan.Timezone.ValueString(),
@@ -278,6 +297,15 @@ func (r *DeploymentScheduleResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentSchedule(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
@@ -332,6 +360,15 @@ func (r *DeploymentScheduleResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentSchedule(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
@@ -353,7 +390,7 @@ func (r *DeploymentScheduleResource) Update(ctx context.Context, req resource.Up
 		Schedule: api.Schedule{
 			AnchorDate: plan.AnchorDate.ValueString(),
 			Cron:       plan.Cron.ValueString(),
-			DayOr:      plan.DayOr.ValueBool(),
+			DayOr:      dayOrPayload(plan.DayOr),
 			Interval:   plan.Interval.ValueFloat32(),
 			RRule:      plan.RRule.ValueString(),
 			Timezone:   plan.Timezone.ValueString(),
@@ -404,6 +441,15 @@ func (r *DeploymentScheduleResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.DeploymentSchedule(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedule", err))
@@ -424,6 +470,16 @@ func (r *DeploymentScheduleResource) Delete(ctx context.Context, req resource.De
 	}
 }
 
+// dayOrPayload returns the day_or value to send. An unknown value, which is
+// the case when it is not configured, is left out so that the server default applies.
+func dayOrPayload(dayOr types.Bool) *bool {
+	if dayOr.IsUnknown() {
+		return nil
+	}
+
+	return dayOr.ValueBoolPointer()
+}
+
 func copyScheduleModelToResourceModel(schedule *api.DeploymentSchedule, model *DeploymentScheduleResourceModel) diag.Diagnostics {
 	model.ID = customtypes.NewUUIDValue(schedule.ID)
 	model.Created = customtypes.NewTimestampPointerValue(schedule.Created)
@@ -439,7 +495,7 @@ func copyScheduleModelToResourceModel(schedule *api.DeploymentSchedule, model *D
 	model.Interval = types.Float32Value(schedule.Schedule.Interval)
 	model.AnchorDate = types.StringValue(schedule.Schedule.AnchorDate)
 	model.Cron = types.StringValue(schedule.Schedule.Cron)
-	model.DayOr = types.BoolValue(schedule.Schedule.DayOr)
+	model.DayOr = types.BoolPointerValue(schedule.Schedule.DayOr)
 	model.RRule = types.StringValue(normalizeRRuleForState(schedule.Schedule.RRule, model.RRule.ValueString()))
 
 	// Some Prefect server versions (notably customer-managed) persist the
@@ -0,0 +1,847 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&DeploymentSchedulesResource{})
+	_ = resource.ResourceWithImportState(&DeploymentSchedulesResource{})
+)
+
+// DeploymentSchedulesResource contains state for the resource.
+type DeploymentSchedulesResource struct {
+	client api.PrefectClient
+}
+
+// DeploymentSchedulesResourceModel defines the Terraform resource model.
+type DeploymentSchedulesResourceModel struct {
+	// ID mirrors the deployment ID, as the resource owns
+	// the deployment's entire set of schedules.
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	DeploymentID customtypes.UUIDValue `tfsdk:"deployment_id"`
+
+	// Schedules is a map of DeploymentSchedulesScheduleModel, keyed by slug.
+	Schedules types.Map `tfsdk:"schedules"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// DeploymentSchedulesScheduleModel defines a single schedule
+// in the `schedules` map.
+type DeploymentSchedulesScheduleModel struct {
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	Active           types.Bool    `tfsdk:"active"`
+	MaxScheduledRuns types.Float32 `tfsdk:"max_scheduled_runs"`
+
+	Timezone types.String `tfsdk:"timezone"`
+
+	// Schedule kind: interval
+	Interval   types.Float32 `tfsdk:"interval"`
+	AnchorDate types.String  `tfsdk:"anchor_date"`
+
+	// Schedule kind: cron
+	Cron  types.String `tfsdk:"cron"`
+	DayOr types.Bool   `tfsdk:"day_or"`
+
+	// Schedule kind: rrule
+	RRule types.String `tfsdk:"rrule"`
+
+	Parameters jsontypes.Normalized `tfsdk:"parameters"`
+}
+
+// deploymentSchedulesScheduleAttrTypes are the attribute types of
+// a single element in the `schedules` map.
+var deploymentSchedulesScheduleAttrTypes = map[string]attr.Type{
+	"id":                 customtypes.UUIDType{},
+	"active":             types.BoolType,
+	"max_scheduled_runs": types.Float32Type,
+	"timezone":           types.StringType,
+	"interval":           types.Float32Type,
+	"anchor_date":        types.StringType,
+	"cron":               types.StringType,
+	"day_or":             types.BoolType,
+	"rrule":              types.StringType,
+	"parameters":         jsontypes.NormalizedType{},
+}
+
+// NewDeploymentSchedulesResource returns a new DeploymentSchedulesResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewDeploymentSchedulesResource() resource.Resource {
+	return &DeploymentSchedulesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DeploymentSchedulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_schedules"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *DeploymentSchedulesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *DeploymentSchedulesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+The resource 'deployment_schedules' authoritatively manages the full set of schedules for a deployment.
+<br>
+Schedules are keyed by their slug. Any schedule on the deployment that is not present in the `+"`schedules`"+` map,
+such as one created by `+"`prefect deploy`"+` or through the UI, is reported as drift and removed on the next apply.
+A schedule created after the last refresh is not shown in the plan, so it is left untouched and reported until the next plan.
+<br>
+Do not use this resource together with `+"`prefect_deployment_schedule`"+` for the same deployment, as the two will conflict.
+The same applies to a deployment that declares its schedules in the `+"`schedules`"+` attribute of `+"`prefect_deployment`"+`.
+Once the schedules are created, the deployment rejects its plans until either this resource or the `+"`schedules`"+` attribute is removed.
+<br>
+For more information, see [schedule flow runs](https://docs.prefect.io/v3/automate/add-schedules).
+`,
+			helpers.AllPlans...,
+		),
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Deployment ID (UUID). Mirrors `deployment_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account ID (UUID)",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Workspace ID (UUID)",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Deployment ID (UUID)",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedules": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Schedules for the deployment, keyed by slug. Schedules on the deployment that are not in this map are deleted.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Deployment Schedule ID (UUID)",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether or not the schedule is active.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"max_scheduled_runs": schema.Float32Attribute{
+							Description: "The maximum number of scheduled runs for the schedule.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"parameters": schema.StringAttribute{
+							Description: "Parameters for flow runs scheduled by the deployment schedule.",
+							Optional:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+							Default:     stringdefault.StaticString("{}"),
+						},
+						// Timezone is a common field for all schedule kinds.
+						"timezone": schema.StringAttribute{
+							Description: "The timezone of the schedule.",
+							Optional:    true,
+							Computed:    true,
+						},
+						// Schedule kind: interval
+						"interval": schema.Float32Attribute{
+							Description: "The interval of the schedule.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"anchor_date": schema.StringAttribute{
+							Description: "The anchor date of the schedule.",
+							Optional:    true,
+							Computed:    true,
+						},
+						// Schedule kind: cron
+						"cron": schema.StringAttribute{
+							Description: "The cron expression of the schedule.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"day_or": schema.BoolAttribute{
+							Description: "Control croniter behavior for handling day and day_of_week entries.",
+							Optional:    true,
+							Computed:    true,
+						},
+						// Schedule kind: rrule
+						"rrule": schema.StringAttribute{
+							Description: "The rrule expression of the schedule.",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *DeploymentSchedulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeploymentSchedulesResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read reads the resource and sets the Terraform state.
+func (r *DeploymentSchedulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeploymentSchedulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// On import, only the ID is set, which mirrors the deployment ID.
+	if state.DeploymentID.IsNull() {
+		state.DeploymentID = state.ID
+	}
+
+	client, err := r.client.DeploymentSchedule(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedules", err))
+
+		return
+	}
+
+	schedules, err := client.Read(ctx, state.DeploymentID.ValueUUID())
+	if err != nil {
+		// If the deployment is not found, remove it from the state.
+		if helpers.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", "read", err))
+
+		return
+	}
+
+	prior, diags := deploymentSchedulesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing := keyDeploymentSchedules(schedules, prior)
+
+	// Report schedules that Terraform does not manage. They are kept in state
+	// so that the plan shows their removal, and are deleted on the next apply.
+	// Skipped on import, where every schedule is unknown to Terraform.
+	if !state.Schedules.IsNull() {
+		if unmanaged := unmanagedDeploymentScheduleKeys(existing, prior); len(unmanaged) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Unmanaged deployment schedules",
+				fmt.Sprintf(
+					"Deployment %s has schedules that are not managed by Terraform: %s. They will be deleted on the next apply.",
+					state.DeploymentID.ValueString(),
+					strings.Join(unmanaged, ", "),
+				),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(copyDeploymentSchedulesToModel(ctx, existing, prior, nil, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *DeploymentSchedulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DeploymentSchedulesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state DeploymentSchedulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := deploymentSchedulesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, prior, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *DeploymentSchedulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DeploymentSchedulesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, err := r.client.DeploymentSchedule(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedules", err))
+
+		return
+	}
+
+	schedules, diags := deploymentSchedulesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, schedule := range schedules {
+		if schedule.ID.IsNull() || schedule.ID.IsUnknown() {
+			continue
+		}
+
+		err = client.Delete(ctx, state.DeploymentID.ValueUUID(), schedule.ID.ValueUUID())
+		if err != nil && !helpers.Is404Error(err) {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", "delete", err))
+
+			return
+		}
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *DeploymentSchedulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByID(ctx, req, resp)
+}
+
+// apply reconciles the deployment's schedules with the planned schedules,
+// then copies the resulting schedules back into the plan.
+//
+// prior holds the schedules in prior state, which include the unmanaged
+// schedules reported on refresh. It is nil on creation.
+func (r *DeploymentSchedulesResource) apply(
+	ctx context.Context,
+	plan *DeploymentSchedulesResourceModel,
+	prior map[string]DeploymentSchedulesScheduleModel,
+	operation string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := r.client.DeploymentSchedule(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Deployment Schedules", err))
+
+		return diags
+	}
+
+	planned, modelDiags := deploymentSchedulesFromModel(ctx, *plan)
+	diags.Append(modelDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired := make(map[string]api.DeploymentSchedulePayload, len(planned))
+	for slug, schedule := range planned {
+		payload, payloadDiags := deploymentSchedulePayloadFromModel(slug, schedule)
+		diags.Append(payloadDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		desired[slug] = payload
+	}
+
+	deploymentID := plan.DeploymentID.ValueUUID()
+
+	known := make(map[string]DeploymentSchedulesScheduleModel, len(prior)+len(planned))
+	maps.Copy(known, prior)
+	maps.Copy(known, planned)
+
+	unmanaged, err := reconcileDeploymentSchedules(ctx, client, deploymentID, desired, known)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", operation, err))
+
+		return diags
+	}
+
+	if len(unmanaged) > 0 {
+		diags.AddWarning(
+			"Unmanaged deployment schedules",
+			fmt.Sprintf(
+				"Deployment %s has schedules that were created since the last refresh: %s. "+
+					"They are left untouched, as the plan did not show their removal, and will be deleted on the next apply.",
+				deploymentID,
+				strings.Join(unmanaged, ", "),
+			),
+		)
+	}
+
+	schedules, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Deployment Schedules", operation, err))
+
+		return diags
+	}
+
+	// Only the planned keys are copied back: a schedule created out of band
+	// while we were applying would otherwise make the result inconsistent
+	// with the plan. It is picked up as drift on the next refresh instead.
+	diags.Append(copyDeploymentSchedulesToModel(ctx, keyDeploymentSchedules(schedules, planned), planned, planned, plan)...)
+
+	return diags
+}
+
+// reconcileDeploymentSchedules applies the minimal set of changes to make the
+// deployment's schedules match the desired schedules, keyed by slug.
+//
+// Only the schedules in known, which were seen when planning, are deleted.
+// Other schedules were created since the last refresh, so the plan did not
+// show their removal: they are left untouched, and their keys are returned
+// so that they can be reported.
+func reconcileDeploymentSchedules(
+	ctx context.Context,
+	client api.DeploymentScheduleClient,
+	deploymentID uuid.UUID,
+	desired map[string]api.DeploymentSchedulePayload,
+	known map[string]DeploymentSchedulesScheduleModel,
+) ([]string, error) {
+	schedules, err := client.Read(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedules: %w", err)
+	}
+
+	existing := keyDeploymentSchedules(schedules, known)
+
+	unmanaged := unmanagedDeploymentScheduleKeys(existing, known)
+	for _, key := range unmanaged {
+		delete(existing, key)
+	}
+
+	changes := diffDeploymentSchedules(desired, existing)
+
+	// Delete first, so that a slug moving between schedules does not collide.
+	for _, scheduleID := range changes.delete {
+		err = client.Delete(ctx, deploymentID, scheduleID)
+		if err != nil && !helpers.Is404Error(err) {
+			return nil, fmt.Errorf("failed to delete schedule %s: %w", scheduleID, err)
+		}
+	}
+
+	for _, update := range changes.update {
+		err = client.Update(ctx, deploymentID, update.id, update.payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update schedule %s: %w", update.id, err)
+		}
+	}
+
+	if len(changes.create) > 0 {
+		_, err = client.Create(ctx, deploymentID, changes.create)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schedules: %w", err)
+		}
+	}
+
+	return unmanaged, nil
+}
+
+// deploymentSchedulesFromModel returns the schedules from the model, keyed by slug.
+// A null or unknown `schedules` map returns an empty map.
+func deploymentSchedulesFromModel(ctx context.Context, model DeploymentSchedulesResourceModel) (map[string]DeploymentSchedulesScheduleModel, diag.Diagnostics) {
+	schedules := map[string]DeploymentSchedulesScheduleModel{}
+	if model.Schedules.IsNull() || model.Schedules.IsUnknown() {
+		return schedules, nil
+	}
+
+	diags := model.Schedules.ElementsAs(ctx, &schedules, false)
+
+	return schedules, diags
+}
+
+// deploymentSchedulePayloadFromModel builds the API payload for a planned schedule.
+// Unknown values (computed attributes that were not configured) are left
+// empty so that they are omitted from the request.
+func deploymentSchedulePayloadFromModel(slug string, model DeploymentSchedulesScheduleModel) (api.DeploymentSchedulePayload, diag.Diagnostics) {
+	parameters, diags := helpers.UnmarshalOptional(model.Parameters)
+
+	payload := api.DeploymentSchedulePayload{
+		Slug:       slug,
+		Parameters: parameters,
+	}
+
+	if !model.Active.IsUnknown() {
+		payload.Active = model.Active.ValueBoolPointer()
+	}
+
+	if !model.MaxScheduledRuns.IsUnknown() {
+		payload.MaxScheduledRuns = model.MaxScheduledRuns.ValueFloat32()
+	}
+
+	if !model.Timezone.IsUnknown() {
+		payload.Schedule.Timezone = model.Timezone.ValueString()
+	}
+
+	if !model.Interval.IsUnknown() {
+		payload.Schedule.Interval = model.Interval.ValueFloat32()
+	}
+
+	if !model.AnchorDate.IsUnknown() {
+		payload.Schedule.AnchorDate = model.AnchorDate.ValueString()
+	}
+
+	if !model.Cron.IsUnknown() {
+		payload.Schedule.Cron = model.Cron.ValueString()
+	}
+
+	if !model.DayOr.IsUnknown() {
+		payload.Schedule.DayOr = model.DayOr.ValueBoolPointer()
+	}
+
+	if !model.RRule.IsUnknown() {
+		payload.Schedule.RRule = model.RRule.ValueString()
+	}
+
+	return payload, diags
+}
+
+// keyDeploymentSchedules keys the schedules returned by the API by slug.
+//
+// Some Prefect server versions omit the slug from schedule responses (see
+// copyScheduleModelToResourceModel), so a schedule without a slug falls back
+// to the key of the known schedule with the same ID, and finally to its ID.
+func keyDeploymentSchedules(schedules []*api.DeploymentSchedule, known map[string]DeploymentSchedulesScheduleModel) map[string]*api.DeploymentSchedule {
+	keysByID := make(map[uuid.UUID]string, len(known))
+	for key, schedule := range known {
+		if !schedule.ID.IsNull() && !schedule.ID.IsUnknown() {
+			keysByID[schedule.ID.ValueUUID()] = key
+		}
+	}
+
+	keyed := make(map[string]*api.DeploymentSchedule, len(schedules))
+	for _, schedule := range schedules {
+		key := schedule.Slug
+		if key == "" {
+			key = keysByID[schedule.ID]
+		}
+
+		if key == "" {
+			key = schedule.ID.String()
+		}
+
+		keyed[key] = schedule
+	}
+
+	return keyed
+}
+
+// unmanagedDeploymentScheduleKeys returns the sorted keys of the existing
+// schedules that are not in the managed set.
+func unmanagedDeploymentScheduleKeys(existing map[string]*api.DeploymentSchedule, managed map[string]DeploymentSchedulesScheduleModel) []string {
+	unmanaged := []string{}
+	for key := range existing {
+		if _, ok := managed[key]; !ok {
+			unmanaged = append(unmanaged, key)
+		}
+	}
+
+	sort.Strings(unmanaged)
+
+	return unmanaged
+}
+
+// deploymentScheduleUpdate is a pending update to an existing schedule.
+type deploymentScheduleUpdate struct {
+	id      uuid.UUID
+	payload api.DeploymentSchedulePayload
+}
+
+// deploymentSchedulesDiff is the set of changes needed to reconcile
+// a deployment's schedules with the desired schedules.
+type deploymentSchedulesDiff struct {
+	create []api.DeploymentSchedulePayload
+	update []deploymentScheduleUpdate
+	delete []uuid.UUID
+}
+
+// diffDeploymentSchedules computes the minimal set of changes to turn the
+// existing schedules into the desired schedules. Both maps are keyed by slug.
+// Results are sorted by slug so that requests are issued in a stable order.
+func diffDeploymentSchedules(desired map[string]api.DeploymentSchedulePayload, existing map[string]*api.DeploymentSchedule) deploymentSchedulesDiff {
+	var changes deploymentSchedulesDiff
+
+	desiredKeys := make([]string, 0, len(desired))
+	for key := range desired {
+		desiredKeys = append(desiredKeys, key)
+	}
+	sort.Strings(desiredKeys)
+
+	for _, key := range desiredKeys {
+		payload := desired[key]
+
+		schedule, ok := existing[key]
+		if !ok {
+			changes.create = append(changes.create, payload)
+
+			continue
+		}
+
+		if deploymentScheduleChanged(payload, schedule) {
+			changes.update = append(changes.update, deploymentScheduleUpdate{id: schedule.ID, payload: payload})
+		}
+	}
+
+	existingKeys := make([]string, 0, len(existing))
+	for key := range existing {
+		if _, ok := desired[key]; !ok {
+			existingKeys = append(existingKeys, key)
+		}
+	}
+	sort.Strings(existingKeys)
+
+	for _, key := range existingKeys {
+		changes.delete = append(changes.delete, existing[key].ID)
+	}
+
+	return changes
+}
+
+// deploymentScheduleChanged reports whether applying the payload would change
+// the existing schedule. Empty payload fields are omitted from the request,
+// so they leave the existing value untouched and are not considered a change.
+func deploymentScheduleChanged(payload api.DeploymentSchedulePayload, schedule *api.DeploymentSchedule) bool {
+	if payload.Active != nil && (schedule.Active == nil || *payload.Active != *schedule.Active) {
+		return true
+	}
+
+	if payload.MaxScheduledRuns != 0 && payload.MaxScheduledRuns != schedule.MaxScheduledRuns {
+		return true
+	}
+
+	if payload.Parameters != nil && !(len(payload.Parameters) == 0 && len(schedule.Parameters) == 0) &&
+		!reflect.DeepEqual(payload.Parameters, schedule.Parameters) {
+		return true
+	}
+
+	desired := payload.Schedule
+	current := schedule.Schedule
+
+	switch {
+	case desired.Timezone != "" && desired.Timezone != current.Timezone,
+		desired.Interval != 0 && desired.Interval != current.Interval,
+		desired.AnchorDate != "" && desired.AnchorDate != current.AnchorDate,
+		desired.Cron != "" && desired.Cron != current.Cron,
+		desired.Cron != "" && desired.DayOr != nil && (current.DayOr == nil || *desired.DayOr != *current.DayOr),
+		desired.RRule != "" && desired.RRule != normalizeRRuleForState(current.RRule, desired.RRule):
+		return true
+	}
+
+	return false
+}
+
+// copyDeploymentSchedulesToModel maps the schedules returned by the API onto the model.
+//
+// prior holds the previously known values (plan or state), used to keep the
+// rrule stable across server-side normalization. When only is non-nil, schedules
+// with keys outside of it are left out.
+func copyDeploymentSchedulesToModel(
+	ctx context.Context,
+	schedules map[string]*api.DeploymentSchedule,
+	prior map[string]DeploymentSchedulesScheduleModel,
+	only map[string]DeploymentSchedulesScheduleModel,
+	model *DeploymentSchedulesResourceModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = model.DeploymentID
+
+	elements := make(map[string]DeploymentSchedulesScheduleModel, len(schedules))
+	for key, schedule := range schedules {
+		if only != nil {
+			if _, ok := only[key]; !ok {
+				continue
+			}
+		}
+
+		element, elementDiags := deploymentSchedulesScheduleModelFromAPI(schedule, prior[key].RRule.ValueString())
+		diags.Append(elementDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		elements[key] = element
+	}
+
+	schedulesMap, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: deploymentSchedulesScheduleAttrTypes}, elements)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Schedules = schedulesMap
+
+	return diags
+}
+
+// deploymentSchedulesScheduleModelFromAPI maps a schedule returned by the API
+// onto a single schedule model. priorRRule is the previously known rrule, used
+// to keep the value stable across server-side normalization.
+func deploymentSchedulesScheduleModelFromAPI(schedule *api.DeploymentSchedule, priorRRule string) (DeploymentSchedulesScheduleModel, diag.Diagnostics) {
+	model := DeploymentSchedulesScheduleModel{
+		ID:               customtypes.NewUUIDValue(schedule.ID),
+		Active:           types.BoolPointerValue(schedule.Active),
+		MaxScheduledRuns: types.Float32Value(schedule.MaxScheduledRuns),
+		Timezone:         types.StringValue(schedule.Schedule.Timezone),
+		Interval:         types.Float32Value(schedule.Schedule.Interval),
+		AnchorDate:       types.StringValue(schedule.Schedule.AnchorDate),
+		Cron:             types.StringValue(schedule.Schedule.Cron),
+		DayOr:            types.BoolPointerValue(schedule.Schedule.DayOr),
+		RRule:            types.StringValue(normalizeRRuleForState(schedule.Schedule.RRule, priorRRule)),
+		Parameters:       jsontypes.NewNormalizedValue("{}"),
+	}
+
+	parametersByteSlice, err := json.Marshal(schedule.Parameters)
+	if err != nil {
+		return model, diag.Diagnostics{helpers.SerializeDataErrorDiagnostic("parameters", "Deployment Schedule parameters", err)}
+	}
+
+	// OSS returns "null" for this field if it's empty, rather than an empty map of "{}".
+	// Fall back to the schema default in that case.
+	if string(parametersByteSlice) != "null" {
+		model.Parameters = jsontypes.NewNormalizedValue(string(parametersByteSlice))
+	}
+
+	return model, nil
+}
@@ -0,0 +1,254 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDeploymentSchedules(t *testing.T) {
+	t.Parallel()
+
+	active := true
+	inactive := false
+
+	hourlyID := uuid.New()
+	dailyID := uuid.New()
+	unmanagedID := uuid.New()
+
+	existing := map[string]*api.DeploymentSchedule{
+		"hourly": {
+			BaseModel: api.BaseModel{ID: hourlyID},
+			DeploymentSchedulePayload: api.DeploymentSchedulePayload{
+				Active:   &active,
+				Slug:     "hourly",
+				Schedule: api.Schedule{Interval: 3600, Timezone: "UTC"},
+			},
+		},
+		"daily": {
+			BaseModel: api.BaseModel{ID: dailyID},
+			DeploymentSchedulePayload: api.DeploymentSchedulePayload{
+				Active:   &active,
+				Slug:     "daily",
+				Schedule: api.Schedule{Cron: "0 0 * * *", Timezone: "UTC"},
+			},
+		},
+		"from-ui": {
+			BaseModel: api.BaseModel{ID: unmanagedID},
+			DeploymentSchedulePayload: api.DeploymentSchedulePayload{
+				Slug:     "from-ui",
+				Schedule: api.Schedule{Interval: 60},
+			},
+		},
+	}
+
+	desired := map[string]api.DeploymentSchedulePayload{
+		// Unchanged: timezone is not configured, so it is left as-is.
+		"hourly": {Slug: "hourly", Active: &active, Schedule: api.Schedule{Interval: 3600}},
+		// Changed: deactivated.
+		"daily": {Slug: "daily", Active: &inactive, Schedule: api.Schedule{Cron: "0 0 * * *"}},
+		// New.
+		"weekly": {Slug: "weekly", Schedule: api.Schedule{RRule: "FREQ=WEEKLY"}},
+	}
+
+	changes := diffDeploymentSchedules(desired, existing)
+
+	assert.Equal(t, []api.DeploymentSchedulePayload{desired["weekly"]}, changes.create)
+	assert.Equal(t, []deploymentScheduleUpdate{{id: dailyID, payload: desired["daily"]}}, changes.update)
+	assert.Equal(t, []uuid.UUID{unmanagedID}, changes.delete)
+}
+
+func TestDiffDeploymentSchedulesEmpty(t *testing.T) {
+	t.Parallel()
+
+	scheduleID := uuid.New()
+	existing := map[string]*api.DeploymentSchedule{
+		"hourly": {BaseModel: api.BaseModel{ID: scheduleID}},
+	}
+
+	changes := diffDeploymentSchedules(map[string]api.DeploymentSchedulePayload{}, existing)
+
+	assert.Empty(t, changes.create)
+	assert.Empty(t, changes.update)
+	assert.Equal(t, []uuid.UUID{scheduleID}, changes.delete)
+}
+
+func TestDeploymentScheduleChanged(t *testing.T) {
+	t.Parallel()
+
+	active := true
+	inactive := false
+
+	existing := &api.DeploymentSchedule{
+		DeploymentSchedulePayload: api.DeploymentSchedulePayload{
+			Active:           &active,
+			MaxScheduledRuns: 10,
+			Schedule: api.Schedule{
+				Timezone: "UTC",
+				RRule:    "DTSTART:20200101T000000\nFREQ=DAILY",
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		payload api.DeploymentSchedulePayload
+		want    bool
+	}{
+		{
+			name:    "empty payload leaves everything untouched",
+			payload: api.DeploymentSchedulePayload{},
+			want:    false,
+		},
+		{
+			name:    "rrule normalized by the server is unchanged",
+			payload: api.DeploymentSchedulePayload{Schedule: api.Schedule{RRule: "FREQ=DAILY"}},
+			want:    false,
+		},
+		{
+			name:    "empty parameters match missing parameters",
+			payload: api.DeploymentSchedulePayload{Parameters: map[string]any{}},
+			want:    false,
+		},
+		{
+			name:    "active changed",
+			payload: api.DeploymentSchedulePayload{Active: &inactive},
+			want:    true,
+		},
+		{
+			name:    "max scheduled runs changed",
+			payload: api.DeploymentSchedulePayload{MaxScheduledRuns: 5},
+			want:    true,
+		},
+		{
+			name:    "parameters changed",
+			payload: api.DeploymentSchedulePayload{Parameters: map[string]any{"env": "prod"}},
+			want:    true,
+		},
+		{
+			name:    "schedule kind changed",
+			payload: api.DeploymentSchedulePayload{Schedule: api.Schedule{Cron: "0 * * * *"}},
+			want:    true,
+		},
+		{
+			name:    "timezone changed",
+			payload: api.DeploymentSchedulePayload{Schedule: api.Schedule{Timezone: "America/Chicago"}},
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, deploymentScheduleChanged(tt.payload, existing))
+		})
+	}
+}
+
+func TestDeploymentScheduleChangedDayOr(t *testing.T) {
+	t.Parallel()
+
+	dayOr := true
+	dayAnd := false
+
+	newSchedule := func(dayOr *bool) *api.DeploymentSchedule {
+		return &api.DeploymentSchedule{
+			DeploymentSchedulePayload: api.DeploymentSchedulePayload{
+				Schedule: api.Schedule{Cron: "0 0 * * *", DayOr: dayOr},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		desired  *bool
+		existing *bool
+		want     bool
+	}{
+		{name: "unset leaves day_or untouched", desired: nil, existing: &dayOr, want: false},
+		{name: "unchanged", desired: &dayOr, existing: &dayOr, want: false},
+		{name: "false to true", desired: &dayOr, existing: &dayAnd, want: true},
+		{name: "true to false", desired: &dayAnd, existing: &dayOr, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := api.DeploymentSchedulePayload{Schedule: api.Schedule{Cron: "0 0 * * *", DayOr: tt.desired}}
+			assert.Equal(t, tt.want, deploymentScheduleChanged(payload, newSchedule(tt.existing)))
+		})
+	}
+}
+
+func TestReconcileDeploymentSchedules(t *testing.T) {
+	t.Parallel()
+
+	hourlyID := uuid.New()
+	nightlyID := uuid.New()
+	fromUIID := uuid.New()
+
+	newSchedule := func(id uuid.UUID, slug string) *api.DeploymentSchedule {
+		return &api.DeploymentSchedule{
+			BaseModel:                 api.BaseModel{ID: id},
+			DeploymentSchedulePayload: api.DeploymentSchedulePayload{Slug: slug, Schedule: api.Schedule{Interval: 3600}},
+		}
+	}
+
+	client := &fakeDeploymentScheduleClient{
+		schedules: []*api.DeploymentSchedule{
+			newSchedule(hourlyID, "hourly"),
+			newSchedule(nightlyID, "nightly"),
+			// Created through the UI since the last refresh.
+			newSchedule(fromUIID, "from-ui"),
+		},
+	}
+
+	desired := map[string]api.DeploymentSchedulePayload{
+		"hourly": {Slug: "hourly", Schedule: api.Schedule{Interval: 3600}},
+	}
+	known := map[string]DeploymentSchedulesScheduleModel{
+		"hourly":  {ID: customtypes.NewUUIDValue(hourlyID)},
+		"nightly": {ID: customtypes.NewUUIDValue(nightlyID)},
+	}
+
+	unmanaged, err := reconcileDeploymentSchedules(context.Background(), client, uuid.New(), desired, known)
+	require.NoError(t, err)
+
+	// Only the schedule seen when planning is deleted, the new one is returned to be reported.
+	assert.Equal(t, []uuid.UUID{nightlyID}, client.deleted)
+	assert.Equal(t, []string{"from-ui"}, unmanaged)
+}
+
+func TestKeyDeploymentSchedules(t *testing.T) {
+	t.Parallel()
+
+	withSlugID := uuid.New()
+	knownID := uuid.New()
+	unknownID := uuid.New()
+
+	schedules := []*api.DeploymentSchedule{
+		{BaseModel: api.BaseModel{ID: withSlugID}, DeploymentSchedulePayload: api.DeploymentSchedulePayload{Slug: "hourly"}},
+		{BaseModel: api.BaseModel{ID: knownID}},
+		{BaseModel: api.BaseModel{ID: unknownID}},
+	}
+
+	known := map[string]DeploymentSchedulesScheduleModel{
+		"daily": {ID: customtypes.NewUUIDValue(knownID)},
+	}
+
+	keyed := keyDeploymentSchedules(schedules, known)
+
+	assert.Len(t, keyed, 3)
+	assert.Equal(t, withSlugID, keyed["hourly"].ID)
+	assert.Equal(t, knownID, keyed["daily"].ID)
+	assert.Equal(t, unknownID, keyed[unknownID.String()].ID)
+
+	assert.ElementsMatch(t, []string{"hourly", unknownID.String()}, unmanagedDeploymentScheduleKeys(keyed, known))
+}
@@ -0,0 +1,200 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+const deploymentSchedulesResourceName = "prefect_deployment_schedules.test"
+
+type deploymentSchedulesFixtureConfig struct {
+	WorkspaceResource string
+	WorkspaceIDArg    string
+	Active            bool
+	IncludeWeekly     bool
+}
+
+func fixtureAccDeploymentSchedules(cfg deploymentSchedulesFixtureConfig) string {
+	tmpl := `
+{{.WorkspaceResource}}
+
+resource "prefect_flow" "test" {
+	name = "my-flow"
+	{{.WorkspaceIDArg}}
+	tags = ["test"]
+}
+
+resource "prefect_deployment" "test" {
+	name = "my-deployment"
+	{{.WorkspaceIDArg}}
+	flow_id = prefect_flow.test.id
+}
+
+resource "prefect_deployment_schedules" "test" {
+	{{.WorkspaceIDArg}}
+	deployment_id = prefect_deployment.test.id
+
+	schedules = {
+		"hourly" = {
+			active = {{.Active}}
+			timezone = "America/New_York"
+			interval = 3600
+			anchor_date = "2024-01-01T00:00:00Z"
+			parameters = jsonencode({
+				env = "test"
+			})
+		}
+		{{- if .IncludeWeekly}}
+		"weekly" = {
+			rrule = "FREQ=WEEKLY;BYDAY=MO"
+		}
+		{{- end}}
+	}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_schedules(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := deploymentSchedulesFixtureConfig{
+		WorkspaceResource: workspace.Resource,
+		WorkspaceIDArg:    workspace.IDArg,
+		Active:            true,
+		IncludeWeekly:     true,
+	}
+
+	cfgUpdate := cfg
+	cfgUpdate.Active = false
+	cfgUpdate.IncludeWeekly = false
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureAccDeploymentSchedules(cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentScheduleSlugs(deploymentSchedulesResourceName, []string{"hourly", "weekly"}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.CompareValuePairs(deploymentSchedulesResourceName, "id", "prefect_deployment.test", "id"),
+					testutils.ExpectKnownValueBool(deploymentSchedulesResourceName, "schedules.hourly.active", true),
+					testutils.ExpectKnownValueNumber(deploymentSchedulesResourceName, "schedules.hourly.interval", 3600),
+					testutils.ExpectKnownValue(deploymentSchedulesResourceName, "schedules.hourly.timezone", "America/New_York"),
+					testutils.ExpectKnownValue(deploymentSchedulesResourceName, "schedules.weekly.rrule", "FREQ=WEEKLY;BYDAY=MO"),
+				},
+			},
+			{
+				// Create a schedule out of band: it is reported as drift and pruned on apply,
+				// along with the schedule removed from the configuration.
+				PreConfig: func() {
+					testAccCreateUnmanagedDeploymentSchedule(t)
+				},
+				Config: fixtureAccDeploymentSchedules(cfgUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentScheduleSlugs(deploymentSchedulesResourceName, []string{"hourly"}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueBool(deploymentSchedulesResourceName, "schedules.hourly.active", false),
+					testutils.ExpectKnownValue(deploymentSchedulesResourceName, "schedules.hourly.timezone", "America/New_York"),
+				},
+			},
+			{
+				ImportState:       true,
+				ResourceName:      deploymentSchedulesResourceName,
+				ImportStateIdFunc: testutils.GetResourceWorkspaceImportStateID(deploymentSchedulesResourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// unmanagedDeploymentScheduleTarget records the deployment that the out-of-band
+// schedule is created for, captured from state during the first test step.
+var unmanagedDeploymentScheduleTarget struct {
+	deploymentID uuid.UUID
+	workspaceID  uuid.UUID
+}
+
+// testAccCheckDeploymentScheduleSlugs checks that the deployment's schedules
+// on the server have exactly the expected slugs.
+func testAccCheckDeploymentScheduleSlugs(resourceName string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		deploymentID, err := testutils.GetResourceIDFromState(s, resourceName)
+		if err != nil {
+			return fmt.Errorf("error fetching deployment ID: %w", err)
+		}
+
+		var workspaceID uuid.UUID
+
+		if !testutils.TestContextOSS() {
+			workspaceID, err = testutils.GetResourceIDFromState(s, testutils.WorkspaceResourceName)
+			if err != nil {
+				return fmt.Errorf("error fetching workspace ID: %w", err)
+			}
+		}
+
+		unmanagedDeploymentScheduleTarget.deploymentID = deploymentID
+		unmanagedDeploymentScheduleTarget.workspaceID = workspaceID
+
+		c, _ := testutils.NewTestClient()
+		schedulesClient, _ := c.DeploymentSchedule(uuid.Nil, workspaceID)
+
+		schedules, err := schedulesClient.Read(context.Background(), deploymentID)
+		if err != nil {
+			return fmt.Errorf("error fetching deployment schedules: %w", err)
+		}
+
+		if len(schedules) != len(expected) {
+			return fmt.Errorf("expected %d schedules, got %d", len(expected), len(schedules))
+		}
+
+		for _, slug := range expected {
+			found := false
+			for _, schedule := range schedules {
+				if schedule.Slug == slug {
+					found = true
+
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected a schedule with slug %q", slug)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCreateUnmanagedDeploymentSchedule creates a schedule outside of Terraform,
+// as `prefect deploy` or the UI would.
+func testAccCreateUnmanagedDeploymentSchedule(t *testing.T) {
+	t.Helper()
+
+	c, _ := testutils.NewTestClient()
+	schedulesClient, _ := c.DeploymentSchedule(uuid.Nil, unmanagedDeploymentScheduleTarget.workspaceID)
+
+	_, err := schedulesClient.Create(context.Background(), unmanagedDeploymentScheduleTarget.deploymentID, []api.DeploymentSchedulePayload{
+		{
+			Slug:     "created-outside-terraform",
+			Schedule: api.Schedule{Cron: "0 12 * * *"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating unmanaged deployment schedule: %s", err)
+	}
+}
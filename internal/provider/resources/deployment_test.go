@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -397,6 +398,128 @@ resource "prefect_deployment" "%[5]s" {
 	})
 }
 
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_deployment_inline_schedules(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	deploymentName := testutils.NewRandomPrefixedString()
+	flowName := testutils.NewRandomPrefixedString()
+	deploymentResourceName := fmt.Sprintf("prefect_deployment.%s", deploymentName)
+
+	// Minimal config function for this focused test
+	makeConfig := func(schedules, extra string) string {
+		return fmt.Sprintf(`
+%[1]s
+
+resource "prefect_flow" "%[3]s" {
+  name = "%[3]s"
+  %[2]s
+}
+
+resource "prefect_deployment" "%[4]s" {
+  name    = "%[4]s"
+  flow_id = prefect_flow.%[3]s.id
+  %[2]s
+
+  schedules = %[5]s
+}
+
+%[6]s
+`, workspace.Resource, workspace.IDArg, flowName, deploymentName, schedules, extra)
+	}
+
+	schedulesCreate := `[
+    {
+      slug     = "hourly"
+      interval = 3600
+      timezone = "America/New_York"
+    },
+    {
+      slug   = "nightly"
+      cron   = "0 0 * * *"
+      active = false
+    },
+  ]`
+
+	schedulesUpdate := `[
+    {
+      slug     = "hourly"
+      interval = 1800
+      timezone = "America/New_York"
+    },
+    {
+      slug  = "weekly"
+      rrule = "FREQ=WEEKLY;BYDAY=MO"
+    },
+  ]`
+
+	standaloneSchedule := fmt.Sprintf(`
+resource "prefect_deployment_schedule" "conflict" {
+  deployment_id = prefect_deployment.%[1]s.id
+  cron          = "0 12 * * *"
+  %[2]s
+}
+`, deploymentName, workspace.IDArg)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Create the deployment with its schedules
+				Config: makeConfig(schedulesCreate, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(deploymentResourceName, "schedules", 2),
+					testutils.ExpectKnownValue(deploymentResourceName, "schedules[0].slug", "hourly"),
+					testutils.ExpectKnownValueNumber(deploymentResourceName, "schedules[0].interval", 3600),
+					testutils.ExpectKnownValue(deploymentResourceName, "schedules[1].slug", "nightly"),
+					testutils.ExpectKnownValue(deploymentResourceName, "schedules[1].cron", "0 0 * * *"),
+					testutils.ExpectKnownValueBool(deploymentResourceName, "schedules[1].active", false),
+				},
+			},
+			{
+				// Update one schedule, replace another
+				Config: makeConfig(schedulesUpdate, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(deploymentResourceName, "schedules", 2),
+					testutils.ExpectKnownValueNumber(deploymentResourceName, "schedules[0].interval", 1800),
+					testutils.ExpectKnownValue(deploymentResourceName, "schedules[1].slug", "weekly"),
+					testutils.ExpectKnownValue(deploymentResourceName, "schedules[1].rrule", "FREQ=WEEKLY;BYDAY=MO"),
+				},
+			},
+			{
+				// Once created, a standalone schedule on a deployment with inline schedules is rejected
+				Config:      makeConfig(schedulesUpdate, standaloneSchedule),
+				ExpectError: regexp.MustCompile("Conflicting deployment schedules"),
+			},
+			{
+				// Stop managing the schedules inline, which leaves them in place
+				Config: makeConfig("null", standaloneSchedule),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(deploymentResourceName, "schedules"),
+				},
+			},
+			{
+				// Remove the standalone schedule, then adopt the remaining schedules by slug
+				Config: makeConfig("null", ""),
+			},
+			{
+				Config: makeConfig(schedulesUpdate, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(deploymentResourceName, "schedules", 2),
+					testutils.ExpectKnownValueNumber(deploymentResourceName, "schedules[0].interval", 1800),
+				},
+			},
+			{
+				// Remove all schedules
+				Config: makeConfig("[]", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(deploymentResourceName, "schedules", 0),
+				},
+			},
+		},
+	})
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_deployment(t *testing.T) {
 	workspace := testutils.NewEphemeralWorkspace()
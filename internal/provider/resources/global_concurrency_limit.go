@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -45,6 +46,14 @@ type GlobalConcurrencyLimitResourceModel struct {
 	SlotDecayPerSecond types.Float64 `tfsdk:"slot_decay_per_second"`
 }
 
+// globalConcurrencyLimitResourceModelWithTimeouts adds the resource-only `timeouts` block to
+// GlobalConcurrencyLimitResourceModel, which is shared with the global concurrency limit data source.
+type globalConcurrencyLimitResourceModelWithTimeouts struct {
+	GlobalConcurrencyLimitResourceModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // NewGlobalConcurrencyLimitResource returns a new GlobalConcurrencyLimitResource.
 //
 //nolint:ireturn // required by Terraform API
@@ -74,7 +83,7 @@ func (r *GlobalConcurrencyLimitResource) Configure(_ context.Context, req resour
 }
 
 // Schema defines the schema for the resource.
-func (r *GlobalConcurrencyLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *GlobalConcurrencyLimitResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `global_concurrency_limit` represents a global concurrency limit. Global concurrency limits allow you to control how many tasks can run simultaneously across all workspaces. For more information, see [apply global concurrency and rate limits](https://docs.prefect.io/v3/develop/global-concurrency-limits).",
@@ -155,18 +164,30 @@ func (r *GlobalConcurrencyLimitResource) Schema(_ context.Context, _ resource.Sc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
 // Create creates a new global concurrency limit.
 func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan GlobalConcurrencyLimitResourceModel
+	var plan globalConcurrencyLimitResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.GlobalConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
@@ -187,7 +208,7 @@ func (r *GlobalConcurrencyLimitResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &plan)
+	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &plan.GlobalConcurrencyLimitResourceModel)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -210,13 +231,22 @@ func copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit *api.GlobalConcurr
 
 // Delete deletes a global concurrency limit.
 func (r *GlobalConcurrencyLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state GlobalConcurrencyLimitResourceModel
+	var state globalConcurrencyLimitResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.GlobalConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
@@ -242,13 +272,22 @@ func (r *GlobalConcurrencyLimitResource) Delete(ctx context.Context, req resourc
 
 // Read reads a global concurrency limit.
 func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state GlobalConcurrencyLimitResourceModel
+	var state globalConcurrencyLimitResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.GlobalConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
@@ -272,7 +311,7 @@ func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &state)
+	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &state.GlobalConcurrencyLimitResourceModel)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -282,13 +321,22 @@ func (r *GlobalConcurrencyLimitResource) Read(ctx context.Context, req resource.
 
 // Update updates a global concurrency limit.
 func (r *GlobalConcurrencyLimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan GlobalConcurrencyLimitResourceModel
+	var plan globalConcurrencyLimitResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.GlobalConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Global Concurrency Limit", err))
@@ -316,7 +364,7 @@ func (r *GlobalConcurrencyLimitResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &plan)
+	copyGlobalConcurrencyLimitToModel(globalConcurrencyLimit, &plan.GlobalConcurrencyLimitResourceModel)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,6 +35,8 @@ type TaskRunConcurrencyLimitResourceModel struct {
 
 	Tag              types.String `tfsdk:"tag"`
 	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewTaskRunConcurrencyLimitResource returns a new TaskRunConcurrencyLimitResource.
@@ -65,7 +68,7 @@ func (r *TaskRunConcurrencyLimitResource) Configure(_ context.Context, req resou
 }
 
 // Schema defines the schema for the resource.
-func (r *TaskRunConcurrencyLimitResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TaskRunConcurrencyLimitResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `task_run_concurrency_limit` represents a task run concurrency limit. Task run concurrency limits allow you to control how many tasks with specific tags can run simultaneously. For more information, see [limit concurrent task runs with tags](https://docs.prefect.io/v3/develop/task-run-limits).",
@@ -129,6 +132,9 @@ func (r *TaskRunConcurrencyLimitResource) Schema(_ context.Context, _ resource.S
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -142,6 +148,15 @@ func (r *TaskRunConcurrencyLimitResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.TaskRunConcurrencyLimits(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Task Run Concurrency Limit", err))
@@ -186,6 +201,15 @@ func (r *TaskRunConcurrencyLimitResource) Delete(ctx context.Context, req resour
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.TaskRunConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Task Run Concurrency Limit", err))
@@ -210,6 +234,15 @@ func (r *TaskRunConcurrencyLimitResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.TaskRunConcurrencyLimits(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Task Run Concurrency Limit", err))
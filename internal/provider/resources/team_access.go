@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -35,6 +36,8 @@ type TeamAccessResourceModel struct {
 	MemberType    types.String          `tfsdk:"member_type"`
 
 	AccountID customtypes.UUIDValue `tfsdk:"account_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewTeamAccessResource returns a new TeamAccessResource.
@@ -66,7 +69,7 @@ func (r *TeamAccessResource) Configure(_ context.Context, req resource.Configure
 }
 
 // Schema returns the schema for the TeamAccessResource.
-func (r *TeamAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TeamAccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `team_access` grants access to a team for a user or service account. "+
@@ -110,6 +113,9 @@ func (r *TeamAccessResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -141,6 +147,7 @@ func waitForTeamAccessToExist(ctx context.Context, client api.TeamAccessClient,
 			// If we successfully read the team access, it exists
 			return nil
 		},
+		retry.Context(ctx),
 		retry.Attempts(maxRetryAttempts),
 		retry.Delay(time.Duration(retryDelay)*time.Millisecond),
 		retry.LastErrorOnly(true),
@@ -167,6 +174,15 @@ func (r *TeamAccessResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.TeamAccess(plan.AccountID.ValueUUID(), plan.TeamID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team Access", err))
@@ -205,6 +221,15 @@ func (r *TeamAccessResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, timeoutDiags := plan.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.TeamAccess(plan.AccountID.ValueUUID(), plan.TeamID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team Access", err))
@@ -245,6 +270,15 @@ func (r *TeamAccessResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.TeamAccess(plan.AccountID.ValueUUID(), plan.TeamID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team Access", err))
@@ -280,6 +314,15 @@ func (r *TeamAccessResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, timeoutDiags := plan.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.TeamAccess(plan.AccountID.ValueUUID(), plan.TeamID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Team Access", err))
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -31,6 +32,8 @@ type UserAPIKeyResourceModel struct {
 	Name       types.String               `tfsdk:"name"`
 	Expiration customtypes.TimestampValue `tfsdk:"expiration"`
 	Key        types.String               `tfsdk:"key"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewUserAPIKeyResource returns a new UserAPIKeyResource.
@@ -62,7 +65,7 @@ func (r *UserAPIKeyResource) Configure(_ context.Context, req resource.Configure
 }
 
 // Schema returns the resource schema.
-func (r *UserAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *UserAPIKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `user_api_key` represents a Prefect User API Key. "+
@@ -125,6 +128,9 @@ func (r *UserAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Sensitive:   true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -148,6 +154,15 @@ func (r *UserAPIKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	userClient, err := r.client.Users()
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("User", err))
@@ -186,6 +201,15 @@ func (r *UserAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	if state.ID.IsNull() {
 		resp.Diagnostics.AddError(
 			"ID is unset",
@@ -240,6 +264,15 @@ func (r *UserAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	userClient, err := r.client.Users()
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("User", err))
@@ -2,11 +2,15 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/dynamicvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
@@ -53,15 +58,21 @@ type VariableResourceModelV0 struct {
 }
 
 // V1: Value is types.Dynamic.
+// ValueWO and ValueWOVersion were added later without a version bump,
+// as new optional attributes are read as null from existing state.
 type VariableResourceModelV1 struct {
 	BaseModel
 
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 
-	Name  types.String  `tfsdk:"name"`
-	Value types.Dynamic `tfsdk:"value"`
-	Tags  types.Set     `tfsdk:"tags"`
+	Name           types.String  `tfsdk:"name"`
+	Value          types.Dynamic `tfsdk:"value"`
+	ValueWO        types.Dynamic `tfsdk:"value_wo"`
+	ValueWOVersion types.Int32   `tfsdk:"value_wo_version"`
+	Tags           types.Set     `tfsdk:"tags"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 var defaultEmptyTagSet, _ = basetypes.NewSetValue(types.StringType, []attr.Value{})
@@ -109,8 +120,31 @@ var VariableResourceSchemaAttributes = map[string]schema.Attribute{
 		Required:    true,
 	},
 	"value": schema.DynamicAttribute{
-		Description: "Value of the variable, supported Terraform value types: string, number, bool, tuple, object",
-		Required:    true,
+		Description: "Value of the variable, supported Terraform value types: string, number, bool, tuple, object. Exactly one of `value` or `value_wo` must be set.",
+		Optional:    true,
+		Validators: []validator.Dynamic{
+			dynamicvalidator.ExactlyOneOf(
+				path.MatchRoot("value_wo"),
+			),
+		},
+	},
+	"value_wo": schema.DynamicAttribute{
+		Description: "Write-only value of the variable, supported Terraform value types: string, number, bool, tuple, object. The value is sent to Prefect but never stored in the plan or state, so drift is not detected. Requires `value_wo_version`.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Validators: []validator.Dynamic{
+			dynamicvalidator.AlsoRequires(
+				path.MatchRoot("value_wo_version"),
+			),
+		},
+	},
+	"value_wo_version": schema.Int32Attribute{
+		Description: "The version of the `value_wo` attribute. This is used to track changes to the `value_wo` attribute and trigger updates when the value changes.",
+		Optional:    true,
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("value_wo")),
+		},
 	},
 	"tags": schema.SetAttribute{
 		Description: "Tags associated with the variable",
@@ -150,15 +184,19 @@ func (r *VariableResource) Configure(_ context.Context, req resource.ConfigureRe
 }
 
 // Schema defines the schema for the resource.
-func (r *VariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VariableResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans("The resource `variable` represents a Prefect Variable. "+
 			"Variables enable you to store and reuse non-sensitive information in your flows. "+
+			"Use the write-only `value_wo` attribute (along with `value_wo_version`) for values that must not be persisted in the Terraform state. "+
 			"For more information, see [set and get variables](https://docs.prefect.io/v3/develop/variables#set-and-get-variables).",
 			helpers.AllPlans...,
 		),
 		Version:    1,
 		Attributes: VariableResourceSchemaAttributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -210,6 +248,11 @@ func (r *VariableResource) UpgradeState(_ context.Context) map[int64]resource.St
 					WorkspaceID: priorStateData.WorkspaceID,
 					Name:        priorStateData.Name,
 					Tags:        priorStateData.Tags,
+					Timeouts:    helpers.NullTimeouts(),
+
+					// Write-only values did not exist in v0.
+					ValueWO:        types.DynamicNull(),
+					ValueWOVersion: types.Int32Null(),
 				}
 
 				// This is the main upgrade operation between v0 => v1.
@@ -240,6 +283,14 @@ func copyVariableToModel(ctx context.Context, variable *api.Variable, tfModel *V
 	}
 	tfModel.Tags = tags
 
+	// Values set through the write-only value_wo attribute are never persisted,
+	// which is tracked by value_wo_version being set.
+	if !tfModel.ValueWOVersion.IsNull() {
+		tfModel.Value = types.DynamicNull()
+
+		return nil
+	}
+
 	// Convert the API value to a types.Dynamic value for Terraform state
 	dynamicValue, convDiags := convertAPIValueToDynamic(ctx, variable.Value)
 	if convDiags.HasError() {
@@ -387,7 +438,13 @@ func convertAttrValueToNative(v attr.Value) (any, diag.Diagnostics) {
 
 // getUnderlyingValue converts the 'value' attribute from a DynamicValue to
 // a native Go type that can be sent to the Prefect API.
-func getUnderlyingValue(plan VariableResourceModelV1) (any, diag.Diagnostics) {
+// When 'value' is not set, the write-only 'value_wo' attribute is used instead,
+// which is only available in the configuration.
+func getUnderlyingValue(plan, config VariableResourceModelV1) (any, diag.Diagnostics) {
+	if plan.Value.IsNull() {
+		return convertAttrValueToNative(config.ValueWO.UnderlyingValue())
+	}
+
 	return convertAttrValueToNative(plan.Value.UnderlyingValue())
 }
 
@@ -401,8 +458,26 @@ func (r *VariableResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	value, diags := getUnderlyingValue(plan)
-	if diags.HasError() {
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Also get the config to evaluate write-only attributes that
+	// are only available in the config, not the plan.
+	var config VariableResourceModelV1
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := getUnderlyingValue(plan, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -452,6 +527,15 @@ func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.Variables(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
@@ -520,6 +604,15 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.Variables(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
@@ -527,11 +620,39 @@ func (r *VariableResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	value, diags := getUnderlyingValue(plan)
-	if diags.HasError() {
+	// Also get the config to evaluate write-only attributes that
+	// are only available in the config, not the plan.
+	var config VariableResourceModelV1
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Also retrieve the state to compare the value_wo_version.
+	var state VariableResourceModelV1
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The write-only value is only sent when value_wo_version changes.
+	// Otherwise, the value is left out of the request and kept as-is.
+	var value json.RawMessage
+	if !plan.Value.IsNull() || !plan.ValueWOVersion.Equal(state.ValueWOVersion) {
+		underlyingValue, diags := getUnderlyingValue(plan, config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		value, err = json.Marshal(underlyingValue)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.SerializeDataErrorDiagnostic("value", "Variable value", err))
+
+			return
+		}
+	}
+
 	var tags []string
 	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
 	if resp.Diagnostics.HasError() {
@@ -583,6 +704,15 @@ func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.Variables(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
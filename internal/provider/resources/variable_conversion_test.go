@@ -1,12 +1,14 @@
-package resources // nolint:testpackage // need access to private convertAPIValueToDynamic function
+package resources // nolint:testpackage // need access to private variable conversion functions
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -222,3 +224,60 @@ func TestConvertAttrValueToNative(t *testing.T) {
 		}, result)
 	})
 }
+
+func TestCopyVariableToModelWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	variable := &api.Variable{Name: "token", Value: "secret", Tags: []string{}}
+
+	t.Run("value is persisted", func(t *testing.T) {
+		t.Parallel()
+
+		model := VariableResourceModelV1{ValueWOVersion: types.Int32Null()}
+
+		diags := copyVariableToModel(ctx, variable, &model)
+		require.False(t, diags.HasError())
+		assert.Equal(t, types.DynamicValue(types.StringValue("secret")), model.Value)
+	})
+
+	t.Run("write-only value is not persisted", func(t *testing.T) {
+		t.Parallel()
+
+		model := VariableResourceModelV1{ValueWOVersion: types.Int32Value(1)}
+
+		diags := copyVariableToModel(ctx, variable, &model)
+		require.False(t, diags.HasError())
+		assert.True(t, model.Value.IsNull())
+		assert.Equal(t, "token", model.Name.ValueString())
+	})
+}
+
+func TestGetUnderlyingValue(t *testing.T) {
+	t.Parallel()
+
+	plan := VariableResourceModelV1{Value: types.DynamicValue(types.StringValue("plain"))}
+	config := VariableResourceModelV1{ValueWO: types.DynamicValue(types.StringValue("secret"))}
+
+	value, diags := getUnderlyingValue(plan, config)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "plain", value)
+
+	value, diags = getUnderlyingValue(VariableResourceModelV1{Value: types.DynamicNull()}, config)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "secret", value)
+}
+
+func TestVariableUpdateValue(t *testing.T) {
+	t.Parallel()
+
+	// A JSON null is sent to clear the value.
+	body, err := json.Marshal(api.VariableUpdate{Name: "token", Value: json.RawMessage("null"), Tags: []string{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "token", "value": null, "tags": []}`, string(body))
+
+	// A nil value is left out of the request, keeping the existing value.
+	body, err = json.Marshal(api.VariableUpdate{Name: "token", Tags: []string{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "token", "tags": []}`, string(body))
+}
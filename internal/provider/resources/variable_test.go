@@ -39,6 +39,38 @@ resource "prefect_variable" "test" {
 	`, workspace, name, value, workspaceIDARg)
 }
 
+func fixtureAccVariableResourceWithTimeouts(workspace, workspaceIDArg, name string, value any) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_variable" "test" {
+	name = "%s"
+	value = %v
+	%s
+
+	timeouts {
+		create = "5m"
+		read   = "1m"
+		update = "5m"
+		delete = "2m"
+	}
+}
+	`, workspace, name, value, workspaceIDArg)
+}
+
+func fixtureAccVariableResourceWriteOnly(workspace, workspaceIDArg, name string, value any, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "prefect_variable" "test" {
+	name = "%s"
+	value_wo = %v
+	value_wo_version = %d
+	%s
+}
+	`, workspace, name, value, version, workspaceIDArg)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_variable(t *testing.T) {
 	randomName := testutils.NewRandomPrefixedString()
@@ -146,6 +178,81 @@ func TestAccResource_variable(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"value"},
 			},
+			{
+				// Check that configuring timeouts does not affect the variable
+				Config: fixtureAccVariableResourceWithTimeouts(workspace.Resource, workspace.IDArg, randomName2, valueStringForResource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName2, Value: valueString}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "timeouts.create", "5m"),
+					testutils.ExpectKnownValue(resourceName, "timeouts.delete", "2m"),
+				},
+			},
+		},
+	})
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variable_write_only(t *testing.T) {
+	randomName := testutils.NewRandomPrefixedString()
+
+	resourceName := "prefect_variable.test"
+
+	workspace := testutils.NewEphemeralWorkspace()
+
+	var variable api.Variable
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that the value is sent to the server, but not persisted in state
+				Config: fixtureAccVariableResourceWriteOnly(workspace.Resource, workspace.IDArg, randomName, `"secret-1"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "secret-1"}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(resourceName, "value"),
+					testutils.ExpectKnownValueNull(resourceName, "value_wo"),
+					testutils.ExpectKnownValueNumber(resourceName, "value_wo_version", 1),
+				},
+			},
+			{
+				// Check that changing the value without bumping the version does not update it
+				Config: fixtureAccVariableResourceWriteOnly(workspace.Resource, workspace.IDArg, randomName, `"secret-2"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "secret-1"}),
+				),
+			},
+			{
+				// Check that bumping the version updates the value
+				Config: fixtureAccVariableResourceWriteOnly(workspace.Resource, workspace.IDArg, randomName, `"secret-2"`, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "secret-2"}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(resourceName, "value"),
+					testutils.ExpectKnownValueNumber(resourceName, "value_wo_version", 2),
+				},
+			},
+			{
+				// Check switching back to a value persisted in state
+				Config: fixtureAccVariableResource(workspace.Resource, workspace.IDArg, randomName, `"not-a-secret"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(resourceName, &variable),
+					testAccCheckVariableValues(&variable, &api.Variable{Name: randomName, Value: "not-a-secret"}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(resourceName, "value", "not-a-secret"),
+					testutils.ExpectKnownValueNull(resourceName, "value_wo_version"),
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,834 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+const (
+	// variablesBatchSize is the number of variable names looked up per request.
+	variablesBatchSize = 50
+	// variablesMaxConcurrentRequests is the number of requests sent in parallel.
+	variablesMaxConcurrentRequests = 10
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&VariablesResource{})
+	_ = resource.ResourceWithImportState(&VariablesResource{})
+	_ = resource.ResourceWithValidateConfig(&VariablesResource{})
+)
+
+// VariablesResource contains state for the resource.
+type VariablesResource struct {
+	client api.PrefectClient
+}
+
+// VariablesResourceModel defines the Terraform resource model.
+type VariablesResourceModel struct {
+	// ID is generated by the provider, as the set of variables
+	// has no identifier of its own in Prefect.
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	ManagedTag types.String `tfsdk:"managed_tag"`
+	Prune      types.Bool   `tfsdk:"prune"`
+
+	// Variables is a map of variable name to VariablesResourceVariableModel.
+	Variables types.Map `tfsdk:"variables"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// VariablesResourceVariableModel defines a single variable in the `variables` map.
+type VariablesResourceVariableModel struct {
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	Value jsontypes.Normalized `tfsdk:"value"`
+	Tags  types.Set            `tfsdk:"tags"`
+}
+
+// variablesVariableAttrTypes are the attribute types of
+// a single element in the `variables` map.
+var variablesVariableAttrTypes = map[string]attr.Type{
+	"id":    customtypes.UUIDType{},
+	"value": jsontypes.NormalizedType{},
+	"tags":  types.SetType{ElemType: types.StringType},
+}
+
+// NewVariablesResource returns a new VariablesResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewVariablesResource() resource.Resource {
+	return &VariablesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *VariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *VariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *VariablesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+The resource 'variables' manages many Prefect Variables at once, from a map of variable name to value and tags.
+<br>
+This is an alternative to one `+"`prefect_variable`"+` resource per variable, which keeps plans fast and state small
+when managing hundreds of variables. Variables are looked up in batches, and changes are sent in parallel.
+Variables in the map that already exist in Prefect are adopted and updated.
+<br>
+When `+"`managed_tag`"+` is set, it is added to every variable managed by this resource.
+With `+"`prune`"+`, variables with the managed tag that are not in the `+"`variables`"+` map, such as those
+removed from the map or created out of band, are reported as drift and deleted on the next apply.
+<br>
+Do not use this resource together with `+"`prefect_variable`"+` for the same variables, as the two will conflict.
+<br>
+For more information, see [set and get variables](https://docs.prefect.io/v3/develop/variables#set-and-get-variables).
+`,
+			helpers.AllPlans...,
+		),
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Identifier of the set of variables (UUID), generated by the provider",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"managed_tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Tag added to every variable managed by this resource. It must not be listed in the `tags` of a variable. Required by `prune`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"prune": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to delete the variables with the `managed_tag` that are not in the `variables` map.",
+			},
+			"variables": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "Variables to manage, keyed by variable name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Variable ID (UUID)",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							CustomType:  jsontypes.NormalizedType{},
+							Description: "Value of the variable, as a JSON string. Use `jsonencode()` on the provided value to satisfy the underlying JSON type.",
+						},
+						"tags": schema.SetAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Tags associated with the variable, besides the `managed_tag`",
+							Default:     setdefault.StaticValue(defaultEmptyTagSet),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig checks that pruning is scoped to a managed tag,
+// and that the managed tag is not listed in the tags of a variable.
+func (r *VariablesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config VariablesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Prune.ValueBool() && config.ManagedTag.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prune"),
+			"Missing managed tag",
+			"`prune` requires `managed_tag` to be set, so that only the variables managed by this resource are deleted.",
+		)
+	}
+
+	if config.ManagedTag.IsNull() || config.ManagedTag.IsUnknown() {
+		return
+	}
+
+	variables, diags := variablesFromModel(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, variable := range variables {
+		if variable.Tags.IsNull() || variable.Tags.IsUnknown() {
+			continue
+		}
+
+		var tags []types.String
+		resp.Diagnostics.Append(variable.Tags.ElementsAs(ctx, &tags, false)...)
+
+		for _, tag := range tags {
+			if tag.ValueString() == config.ManagedTag.ValueString() && !tag.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("variables").AtMapKey(name).AtName("tags"),
+					"Managed tag in tags",
+					fmt.Sprintf("The managed tag %q is added to every variable automatically and must not be listed in `tags`.", config.ManagedTag.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *VariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VariablesResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *VariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// On import, only the managed tag is set.
+	isImport := state.Variables.IsNull()
+	if state.Prune.IsNull() {
+		state.Prune = types.BoolValue(false)
+	}
+
+	if state.ID.IsNull() {
+		state.ID = customtypes.NewUUIDValue(uuid.New())
+	}
+
+	client, err := r.client.Variables(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	known, diags := variablesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Variables that no longer exist are left out, so that they are created again.
+	included, err := listVariablesByName(ctx, client, slices.Sorted(maps.Keys(known)))
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variables", "read", err))
+
+		return
+	}
+
+	// Report variables with the managed tag that Terraform does not manage. When they
+	// are to be pruned, they are kept in state so that the plan shows their removal.
+	// On import, every variable with the managed tag is managed.
+	if !state.ManagedTag.IsNull() && (isImport || state.Prune.ValueBool()) {
+		tagged, err := client.List(ctx, api.VariableFilter{
+			Tags: &api.VariableFilterTags{All: []string{state.ManagedTag.ValueString()}},
+		})
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variables", "read", err))
+
+			return
+		}
+
+		unmanaged := []string{}
+		for _, variable := range tagged {
+			if _, ok := included[variable.Name]; !ok {
+				included[variable.Name] = variable
+				unmanaged = append(unmanaged, variable.Name)
+			}
+		}
+
+		if !isImport && len(unmanaged) > 0 {
+			sort.Strings(unmanaged)
+
+			resp.Diagnostics.AddWarning(
+				"Unmanaged variables",
+				fmt.Sprintf(
+					"Variables with the managed tag %q are not in the `variables` map: %s. They will be deleted on the next apply.",
+					state.ManagedTag.ValueString(),
+					strings.Join(unmanaged, ", "),
+				),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(copyVariablesToModel(ctx, included, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *VariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VariablesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state VariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the managed variables and removes the Terraform state on success.
+func (r *VariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VariablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, err := r.client.Variables(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return
+	}
+
+	known, diags := variablesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stale := make([]api.Variable, 0, len(known))
+	for name, variable := range known {
+		if !variable.ID.IsNull() && !variable.ID.IsUnknown() {
+			stale = append(stale, api.Variable{BaseModel: api.BaseModel{ID: variable.ID.ValueUUID()}, Name: name})
+		}
+	}
+
+	err = deleteVariables(ctx, client, stale)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Variables", "delete", err))
+
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *VariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByManagedTag(ctx, req, resp)
+}
+
+// apply reconciles the variables with the planned variables, then copies the
+// resulting variables back into the plan. The prior state is nil on creation.
+func (r *VariablesResource) apply(ctx context.Context, plan, state *VariablesResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned, modelDiags := variablesFromModel(ctx, *plan)
+	diags.Append(modelDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired := make(map[string]api.VariableCreate, len(planned))
+	for name, variable := range planned {
+		payload, payloadDiags := variablePayloadFromModel(ctx, name, variable, plan.ManagedTag.ValueString())
+		diags.Append(payloadDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		desired[name] = payload
+	}
+
+	client, err := r.client.Variables(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Variable", err))
+
+		return diags
+	}
+
+	names := slices.Sorted(maps.Keys(desired))
+
+	existing, err := listVariablesByName(ctx, client, names)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Variables", operation, err))
+
+		return diags
+	}
+
+	// Variables removed from the map, and with pruning,
+	// the variables with the managed tag, are candidates for deletion.
+	var candidates []api.Variable
+	if state != nil {
+		known, modelDiags := variablesFromModel(ctx, *state)
+		diags.Append(modelDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for name, variable := range known {
+			if !variable.ID.IsNull() && !variable.ID.IsUnknown() {
+				candidates = append(candidates, api.Variable{BaseModel: api.BaseModel{ID: variable.ID.ValueUUID()}, Name: name})
+			}
+		}
+	}
+
+	if plan.Prune.ValueBool() && !plan.ManagedTag.IsNull() {
+		tagged, err := client.List(ctx, api.VariableFilter{
+			Tags: &api.VariableFilterTags{All: []string{plan.ManagedTag.ValueString()}},
+		})
+		if err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Variables", operation, err))
+
+			return diags
+		}
+
+		candidates = append(candidates, tagged...)
+	}
+
+	changes, err := diffVariables(desired, existing, candidates)
+	if err != nil {
+		diags.Append(helpers.SerializeDataErrorDiagnostic("variables", "Variable value", err))
+
+		return diags
+	}
+
+	// Removed variables are deleted first, then the desired
+	// variables are created and updated.
+	err = deleteVariables(ctx, client, changes.delete)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Variables", operation, err))
+
+		return diags
+	}
+
+	requests := make([]func(context.Context) error, 0, len(changes.create)+len(changes.update))
+	for _, payload := range changes.create {
+		requests = append(requests, func(ctx context.Context) error {
+			if _, err := client.Create(ctx, payload); err != nil {
+				return fmt.Errorf("variable %q: %w", payload.Name, err)
+			}
+
+			return nil
+		})
+	}
+
+	for _, change := range changes.update {
+		requests = append(requests, func(ctx context.Context) error {
+			if err := client.Update(ctx, change.id, change.payload); err != nil {
+				return fmt.Errorf("variable %q: %w", change.payload.Name, err)
+			}
+
+			return nil
+		})
+	}
+
+	err = runVariableRequests(ctx, requests)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Variables", operation, err))
+
+		return diags
+	}
+
+	applied, err := listVariablesByName(ctx, client, names)
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Variables", operation, err))
+
+		return diags
+	}
+
+	for _, name := range names {
+		if _, ok := applied[name]; !ok {
+			diags.AddError(
+				"Variable not found",
+				fmt.Sprintf("Variable %q was not found after being applied.", name),
+			)
+
+			return diags
+		}
+	}
+
+	if plan.ID.IsUnknown() || plan.ID.IsNull() {
+		plan.ID = customtypes.NewUUIDValue(uuid.New())
+	}
+
+	diags.Append(copyVariablesToModel(ctx, applied, plan)...)
+
+	return diags
+}
+
+// variablesFromModel returns the variables from the model, keyed by name.
+// A null or unknown `variables` map returns an empty map.
+func variablesFromModel(ctx context.Context, model VariablesResourceModel) (map[string]VariablesResourceVariableModel, diag.Diagnostics) {
+	variables := map[string]VariablesResourceVariableModel{}
+	if model.Variables.IsNull() || model.Variables.IsUnknown() {
+		return variables, nil
+	}
+
+	diags := model.Variables.ElementsAs(ctx, &variables, false)
+
+	return variables, diags
+}
+
+// variablePayloadFromModel builds the API payload for a planned variable.
+// The managed tag, if any, is added to the tags of the variable.
+func variablePayloadFromModel(ctx context.Context, name string, model VariablesResourceVariableModel, managedTag string) (api.VariableCreate, diag.Diagnostics) {
+	payload := api.VariableCreate{Name: name}
+
+	var value any
+	diags := model.Value.Unmarshal(&value)
+	if diags.HasError() {
+		return payload, diags
+	}
+
+	payload.Value = value
+
+	tags := []string{}
+	if !model.Tags.IsNull() && !model.Tags.IsUnknown() {
+		diags.Append(model.Tags.ElementsAs(ctx, &tags, false)...)
+		if diags.HasError() {
+			return payload, diags
+		}
+	}
+
+	if managedTag != "" && !slices.Contains(tags, managedTag) {
+		tags = append(tags, managedTag)
+	}
+
+	sort.Strings(tags)
+	payload.Tags = tags
+
+	return payload, diags
+}
+
+// variableUpdate is a pending update to an existing variable.
+type variableUpdate struct {
+	id      uuid.UUID
+	payload api.VariableUpdate
+}
+
+// variablesDiff is the set of changes needed to reconcile
+// the existing variables with the desired variables.
+type variablesDiff struct {
+	create []api.VariableCreate
+	update []variableUpdate
+	delete []api.Variable
+}
+
+// diffVariables computes the changes to turn the existing variables into the
+// desired variables. Desired variables that already exist are updated when they
+// differ, and candidates for deletion that are not desired are deleted once.
+// Each list is sorted by name.
+func diffVariables(desired map[string]api.VariableCreate, existing map[string]api.Variable, candidates []api.Variable) (variablesDiff, error) {
+	var changes variablesDiff
+
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		payload := desired[name]
+
+		variable, ok := existing[name]
+		if !ok {
+			changes.create = append(changes.create, payload)
+
+			continue
+		}
+
+		if variableChanged(payload, variable) {
+			// The value is always sent, including a JSON null.
+			value, err := json.Marshal(payload.Value)
+			if err != nil {
+				return changes, fmt.Errorf("failed to encode the value of variable %q: %w", name, err)
+			}
+
+			changes.update = append(changes.update, variableUpdate{
+				id: variable.ID,
+				payload: api.VariableUpdate{
+					Name:  payload.Name,
+					Value: value,
+					Tags:  payload.Tags,
+				},
+			})
+		}
+	}
+
+	deleted := map[uuid.UUID]bool{}
+	for _, variable := range candidates {
+		if _, ok := desired[variable.Name]; ok || deleted[variable.ID] {
+			continue
+		}
+
+		deleted[variable.ID] = true
+		changes.delete = append(changes.delete, variable)
+	}
+
+	sort.SliceStable(changes.delete, func(i, j int) bool {
+		return changes.delete[i].Name < changes.delete[j].Name
+	})
+
+	return changes, nil
+}
+
+// variableChanged reports whether applying the payload would change the existing variable.
+func variableChanged(payload api.VariableCreate, variable api.Variable) bool {
+	tags := slices.Clone(variable.Tags)
+	sort.Strings(tags)
+
+	return !reflect.DeepEqual(payload.Value, variable.Value) || !slices.Equal(payload.Tags, tags)
+}
+
+// listVariablesByName returns the variables with the given names, keyed by name.
+// Names are looked up in batches, which are sent in parallel.
+func listVariablesByName(ctx context.Context, client api.VariablesClient, names []string) (map[string]api.Variable, error) {
+	var mutex sync.Mutex
+	variables := make(map[string]api.Variable, len(names))
+
+	requests := []func(context.Context) error{}
+	for batch := range slices.Chunk(names, variablesBatchSize) {
+		requests = append(requests, func(ctx context.Context) error {
+			found, err := client.List(ctx, api.VariableFilter{
+				Name: &api.VariableFilterName{Any: batch},
+			})
+			if err != nil {
+				return err
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			for _, variable := range found {
+				variables[variable.Name] = variable
+			}
+
+			return nil
+		})
+	}
+
+	err := runVariableRequests(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+// deleteVariables deletes the given variables in parallel.
+// Variables that no longer exist are ignored.
+func deleteVariables(ctx context.Context, client api.VariablesClient, variables []api.Variable) error {
+	requests := make([]func(context.Context) error, 0, len(variables))
+	for _, variable := range variables {
+		requests = append(requests, func(ctx context.Context) error {
+			err := client.Delete(ctx, variable.ID)
+			if err != nil && !helpers.Is404Error(err) {
+				return fmt.Errorf("variable %q: %w", variable.Name, err)
+			}
+
+			return nil
+		})
+	}
+
+	return runVariableRequests(ctx, requests)
+}
+
+// runVariableRequests runs the requests in parallel, with at most
+// variablesMaxConcurrentRequests at a time, and returns the first error.
+// The remaining requests are canceled on error.
+func runVariableRequests(ctx context.Context, requests []func(context.Context) error) error {
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(variablesMaxConcurrentRequests)
+
+	for _, request := range requests {
+		group.Go(func() error {
+			return request(ctx)
+		})
+	}
+
+	return group.Wait()
+}
+
+// copyVariablesToModel maps the variables onto the model.
+// The managed tag is left out of the tags of each variable.
+func copyVariablesToModel(ctx context.Context, variables map[string]api.Variable, model *VariablesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elements := make(map[string]VariablesResourceVariableModel, len(variables))
+	for name, variable := range variables {
+		byteSlice, err := json.Marshal(variable.Value)
+		if err != nil {
+			diags.Append(helpers.SerializeDataErrorDiagnostic("variables", "Variable Value", err))
+
+			return diags
+		}
+
+		tags := []string{}
+		for _, tag := range variable.Tags {
+			if tag != model.ManagedTag.ValueString() || model.ManagedTag.IsNull() {
+				tags = append(tags, tag)
+			}
+		}
+
+		tagsSet, setDiags := types.SetValueFrom(ctx, types.StringType, tags)
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		elements[name] = VariablesResourceVariableModel{
+			ID:    customtypes.NewUUIDValue(variable.ID),
+			Value: jsontypes.NewNormalizedValue(string(byteSlice)),
+			Tags:  tagsSet,
+		}
+	}
+
+	variablesMap, mapDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: variablesVariableAttrTypes}, elements)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Variables = variablesMap
+
+	return diags
+}
@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffVariables(t *testing.T) {
+	t.Parallel()
+
+	unchangedID := uuid.New()
+	changedID := uuid.New()
+	nulledID := uuid.New()
+	removedID := uuid.New()
+	strayID := uuid.New()
+
+	desired := map[string]api.VariableCreate{
+		"unchanged": {Name: "unchanged", Value: "a", Tags: []string{"managed", "ops"}},
+		"changed":   {Name: "changed", Value: float64(2), Tags: []string{"managed"}},
+		"new":       {Name: "new", Value: true, Tags: []string{"managed"}},
+		// Set to jsonencode(null).
+		"nulled": {Name: "nulled", Value: nil, Tags: []string{"managed"}},
+	}
+
+	existing := map[string]api.Variable{
+		"unchanged": {BaseModel: api.BaseModel{ID: unchangedID}, Name: "unchanged", Value: "a", Tags: []string{"ops", "managed"}},
+		"changed":   {BaseModel: api.BaseModel{ID: changedID}, Name: "changed", Value: float64(1), Tags: []string{"managed"}},
+		"nulled":    {BaseModel: api.BaseModel{ID: nulledID}, Name: "nulled", Value: "previous", Tags: []string{"managed"}},
+	}
+
+	candidates := []api.Variable{
+		// Removed from the map.
+		{BaseModel: api.BaseModel{ID: removedID}, Name: "removed"},
+		// Tagged, and still in the map.
+		{BaseModel: api.BaseModel{ID: unchangedID}, Name: "unchanged"},
+		// Tagged, created out of band.
+		{BaseModel: api.BaseModel{ID: strayID}, Name: "stray"},
+		// Both removed from the map and tagged.
+		{BaseModel: api.BaseModel{ID: removedID}, Name: "removed"},
+	}
+
+	changes, err := diffVariables(desired, existing, candidates)
+	require.NoError(t, err)
+
+	assert.Equal(t, []api.VariableCreate{desired["new"]}, changes.create)
+	assert.Equal(t, []variableUpdate{
+		{id: changedID, payload: api.VariableUpdate{Name: "changed", Value: json.RawMessage("2"), Tags: []string{"managed"}}},
+		{id: nulledID, payload: api.VariableUpdate{Name: "nulled", Value: json.RawMessage("null"), Tags: []string{"managed"}}},
+	}, changes.update)
+
+	// A null value is sent, rather than left out of the request which would keep the previous value.
+	body, err := json.Marshal(changes.update[1].payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "nulled", "value": null, "tags": ["managed"]}`, string(body))
+	assert.Equal(t, []api.Variable{
+		{BaseModel: api.BaseModel{ID: removedID}, Name: "removed"},
+		{BaseModel: api.BaseModel{ID: strayID}, Name: "stray"},
+	}, changes.delete)
+}
+
+func TestVariableChanged(t *testing.T) {
+	t.Parallel()
+
+	variable := api.Variable{
+		Name:  "settings",
+		Value: map[string]any{"debug": false, "targets": []any{"a", "b"}},
+		Tags:  []string{"ops", "managed"},
+	}
+
+	tests := []struct {
+		name    string
+		payload api.VariableCreate
+		want    bool
+	}{
+		{"unchanged", api.VariableCreate{Value: map[string]any{"debug": false, "targets": []any{"a", "b"}}, Tags: []string{"managed", "ops"}}, false},
+		{"value", api.VariableCreate{Value: map[string]any{"debug": true, "targets": []any{"a", "b"}}, Tags: []string{"managed", "ops"}}, true},
+		{"value type", api.VariableCreate{Value: "debug", Tags: []string{"managed", "ops"}}, true},
+		{"tags", api.VariableCreate{Value: map[string]any{"debug": false, "targets": []any{"a", "b"}}, Tags: []string{"managed"}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, variableChanged(tc.payload, variable))
+		})
+	}
+}
+
+func TestVariablePayloadFromModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, []string{"ops"})
+	require.False(t, diags.HasError())
+
+	model := VariablesResourceVariableModel{
+		Value: jsontypes.NewNormalizedValue(`{"retries": 3}`),
+		Tags:  tags,
+	}
+
+	payload, diags := variablePayloadFromModel(ctx, "settings", model, "managed")
+	require.False(t, diags.HasError())
+	assert.Equal(t, api.VariableCreate{
+		Name:  "settings",
+		Value: map[string]any{"retries": float64(3)},
+		Tags:  []string{"managed", "ops"},
+	}, payload)
+
+	payload, diags = variablePayloadFromModel(ctx, "settings", model, "")
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"ops"}, payload.Tags)
+}
+
+func TestCopyVariablesToModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	variableID := uuid.New()
+
+	model := VariablesResourceModel{ManagedTag: types.StringValue("managed")}
+
+	diags := copyVariablesToModel(ctx, map[string]api.Variable{
+		"region": {BaseModel: api.BaseModel{ID: variableID}, Name: "region", Value: "us-east-1", Tags: []string{"managed", "ops"}},
+	}, &model)
+	require.False(t, diags.HasError())
+
+	variables, diags := variablesFromModel(ctx, model)
+	require.False(t, diags.HasError())
+	require.Contains(t, variables, "region")
+
+	var tags []string
+	require.False(t, variables["region"].Tags.ElementsAs(ctx, &tags, false).HasError())
+
+	assert.Equal(t, variableID.String(), variables["region"].ID.ValueString())
+	assert.Equal(t, `"us-east-1"`, variables["region"].Value.ValueString())
+	assert.Equal(t, []string{"ops"}, tags, "the managed tag must be left out")
+}
@@ -0,0 +1,199 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+const variablesResourceName = "prefect_variables.test"
+
+type variablesFixtureConfig struct {
+	WorkspaceResource string
+	WorkspaceIDArg    string
+	ManagedTag        string
+	Prefix            string
+	Retries           int
+	WithRegion        bool
+}
+
+func fixtureAccVariables(cfg variablesFixtureConfig) string {
+	tmpl := `
+{{.WorkspaceResource}}
+
+resource "prefect_variables" "test" {
+	managed_tag = "{{.ManagedTag}}"
+	prune = true
+	{{.WorkspaceIDArg}}
+
+	variables = {
+		"{{.Prefix}}_retries" = {
+			value = jsonencode({{.Retries}})
+			tags = ["ops"]
+		}
+		{{- if .WithRegion}}
+		"{{.Prefix}}_region" = {
+			value = jsonencode("us-east-1")
+		}
+		{{- end}}
+		"{{.Prefix}}_settings" = {
+			value = jsonencode({ "debug" = false })
+		}
+	}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_variables(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+	prefix := testutils.NewRandomPrefixedString()
+
+	cfg := variablesFixtureConfig{
+		WorkspaceResource: workspace.Resource,
+		WorkspaceIDArg:    workspace.IDArg,
+		ManagedTag:        prefix,
+		Prefix:            prefix,
+		Retries:           3,
+		WithRegion:        true,
+	}
+
+	cfgUpdated := cfg
+	cfgUpdated.Retries = 5
+	cfgUpdated.WithRegion = false
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check creation of the variables, with the managed tag
+				Config: fixtureAccVariables(cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariablesValues(prefix, map[string]any{
+						prefix + "_retries":  float64(3),
+						prefix + "_region":   "us-east-1",
+						prefix + "_settings": map[string]any{"debug": false},
+					}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNotNull(variablesResourceName, "id"),
+					testutils.ExpectKnownValueNotNull(variablesResourceName, fmt.Sprintf("variables.%s_retries.id", prefix)),
+					testutils.ExpectKnownValueSet(variablesResourceName, fmt.Sprintf("variables.%s_retries.tags", prefix), []string{"ops"}),
+				},
+			},
+			{
+				// Create a variable with the managed tag out of band: it is pruned on apply,
+				// while a variable is removed from the map and another one is updated.
+				PreConfig: func() {
+					testAccCreateUnmanagedVariable(t, prefix)
+				},
+				Config: fixtureAccVariables(cfgUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariablesValues(prefix, map[string]any{
+						prefix + "_retries":  float64(5),
+						prefix + "_settings": map[string]any{"debug": false},
+					}),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(variablesResourceName, fmt.Sprintf("variables.%s_retries.value", prefix), "5"),
+				},
+			},
+			{
+				ImportState:  true,
+				ResourceName: variablesResourceName,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					if testutils.TestContextOSS() {
+						return prefix, nil
+					}
+
+					workspaceID, err := testutils.GetResourceWorkspaceIDFromState(state)
+					if err != nil {
+						return "", fmt.Errorf("unable to get workspaceID from state: %w", err)
+					}
+
+					return fmt.Sprintf("%s,%s", prefix, workspaceID), nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "managed_tag",
+				ImportStateVerifyIgnore:              []string{"id", "prune"},
+			},
+		},
+	})
+}
+
+// variablesWorkspaceID records the workspace of the variables,
+// captured from state during the test steps.
+var variablesWorkspaceID uuid.UUID
+
+// testAccCheckVariablesValues checks that the variables with the managed tag
+// on the server are exactly the expected ones.
+func testAccCheckVariablesValues(managedTag string, expected map[string]any) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var workspaceID uuid.UUID
+		var err error
+
+		if !testutils.TestContextOSS() {
+			workspaceID, err = testutils.GetResourceIDFromState(s, testutils.WorkspaceResourceName)
+			if err != nil {
+				return fmt.Errorf("error fetching workspace ID: %w", err)
+			}
+		}
+
+		variablesWorkspaceID = workspaceID
+
+		c, _ := testutils.NewTestClient()
+		variablesClient, _ := c.Variables(uuid.Nil, workspaceID)
+
+		variables, err := variablesClient.List(context.Background(), api.VariableFilter{
+			Tags: &api.VariableFilterTags{All: []string{managedTag}},
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching variables: %w", err)
+		}
+
+		if len(variables) != len(expected) {
+			return fmt.Errorf("expected %d variables, got %d", len(expected), len(variables))
+		}
+
+		for _, variable := range variables {
+			value, ok := expected[variable.Name]
+			if !ok {
+				return fmt.Errorf("unexpected variable %q", variable.Name)
+			}
+
+			if !reflect.DeepEqual(variable.Value, value) {
+				return fmt.Errorf("expected variable %q to be %v, got %v", variable.Name, value, variable.Value)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCreateUnmanagedVariable creates a variable with the managed tag outside of Terraform.
+func testAccCreateUnmanagedVariable(t *testing.T, managedTag string) {
+	t.Helper()
+
+	c, _ := testutils.NewTestClient()
+	variablesClient, _ := c.Variables(uuid.Nil, variablesWorkspaceID)
+
+	_, err := variablesClient.Create(context.Background(), api.VariableCreate{
+		Name:  managedTag + "_created_outside_terraform",
+		Value: "stray",
+		Tags:  []string{managedTag},
+	})
+	if err != nil {
+		t.Fatalf("error creating unmanaged variable: %s", err)
+	}
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"encoding/json"
 
-	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -22,6 +23,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&WorkPoolResource{})
 	_ = resource.ResourceWithImportState(&WorkPoolResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkPoolResource{})
 )
 
 // WorkPoolResource contains state for the resource.
@@ -36,13 +38,17 @@ type WorkPoolResourceModel struct {
 	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
 	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
 
-	Name             types.String          `tfsdk:"name"`
-	Description      types.String          `tfsdk:"description"`
-	Type             types.String          `tfsdk:"type"`
-	Paused           types.Bool            `tfsdk:"paused"`
-	ConcurrencyLimit types.Int64           `tfsdk:"concurrency_limit"`
-	DefaultQueueID   customtypes.UUIDValue `tfsdk:"default_queue_id"`
-	BaseJobTemplate  jsontypes.Normalized  `tfsdk:"base_job_template"`
+	Name             types.String                     `tfsdk:"name"`
+	Description      types.String                     `tfsdk:"description"`
+	Type             types.String                     `tfsdk:"type"`
+	Paused           types.Bool                       `tfsdk:"paused"`
+	ConcurrencyLimit types.Int64                      `tfsdk:"concurrency_limit"`
+	DefaultQueueID   customtypes.UUIDValue            `tfsdk:"default_queue_id"`
+	BaseJobTemplate  customtypes.BaseJobTemplateValue `tfsdk:"base_job_template"`
+
+	StorageConfiguration *WorkPoolStorageConfigurationModel `tfsdk:"storage_configuration"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewWorkPoolResource returns a new WorkPoolResource.
@@ -74,7 +80,7 @@ func (r *WorkPoolResource) Configure(_ context.Context, req resource.ConfigureRe
 }
 
 // Schema defines the schema for the resource.
-func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WorkPoolResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans("The resource `work_pool` represents a Prefect Work Pool. "+
 			"Work Pools represent infrastructure configurations for jobs across several common environments.\n"+
@@ -169,10 +175,14 @@ func (r *WorkPoolResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"base_job_template": schema.StringAttribute{
-				CustomType:  jsontypes.NormalizedType{},
-				Description: "The base job template for the work pool, as a JSON string",
+				CustomType:  customtypes.BaseJobTemplateType{},
+				Description: "The base job template for the work pool, as a JSON string. Differences that do not change the template, such as `null` values, whitespace inside `{{ }}` placeholders or JSON Schema keywords set to their default value, do not show up as changes.",
 				Optional:    true,
 			},
+			"storage_configuration": workPoolStorageConfigurationAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
 		},
 	}
 }
@@ -198,10 +208,58 @@ func copyWorkPoolToModel(pool *api.WorkPool, tfModel *WorkPoolResourceModel) dia
 		if err != nil {
 			return helpers.SerializeDataErrorDiagnostic("data", "Base Job Template", err)
 		}
-		tfModel.BaseJobTemplate = jsontypes.NewNormalizedValue(string(byteSlice))
+		tfModel.BaseJobTemplate = customtypes.NewBaseJobTemplateValue(string(byteSlice))
 	}
 
-	return nil
+	return copyWorkPoolStorageConfigurationToModel(pool.StorageConfiguration, tfModel)
+}
+
+// ModifyPlan checks that the default result storage block exists
+// and can be used as result storage.
+func (r *WorkPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	blockPath := path.Root("storage_configuration").AtName("default_result_storage_block_id")
+
+	var blockID customtypes.UUIDValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, blockPath, &blockID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The block can't be looked up until it is known, for example
+	// when it is created in the same apply.
+	if blockID.IsNull() || blockID.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateBlockID customtypes.UUIDValue
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, blockPath, &stateBlockID)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if blockID.Equal(stateBlockID) {
+			return
+		}
+	}
+
+	var accountID, workspaceID customtypes.UUIDValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("account_id"), &accountID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if accountID.IsUnknown() || workspaceID.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWorkPoolStorageConfiguration(ctx, r.client, accountID, workspaceID, blockID)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -214,6 +272,15 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkPools(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
@@ -231,7 +298,7 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 
 	// only append the deserialized base job template if it is provided in the user's config
 	if !plan.BaseJobTemplate.IsNull() {
-		baseJobTemplate, diags := helpers.UnmarshalOptional(plan.BaseJobTemplate)
+		baseJobTemplate, diags := helpers.UnmarshalOptional(plan.BaseJobTemplate.Normalized)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -239,6 +306,13 @@ func (r *WorkPoolResource) Create(ctx context.Context, req resource.CreateReques
 		payload.BaseJobTemplate = &baseJobTemplate
 	}
 
+	storageConfiguration, diags := workPoolStorageConfigurationFromModel(plan.StorageConfiguration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	payload.StorageConfiguration = storageConfiguration
+
 	pool, err := client.Create(ctx, payload)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "create", err))
@@ -267,6 +341,15 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkPools(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
@@ -305,12 +388,23 @@ func (r *WorkPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan WorkPoolResourceModel
+	var state WorkPoolResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkPools(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
@@ -326,7 +420,7 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// only append the deserialized base job template if it is provided in the user's config
 	if !plan.BaseJobTemplate.IsNull() {
-		baseJobTemplate, diags := helpers.UnmarshalOptional(plan.BaseJobTemplate)
+		baseJobTemplate, diags := helpers.UnmarshalOptional(plan.BaseJobTemplate.Normalized)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -335,6 +429,19 @@ func (r *WorkPoolResource) Update(ctx context.Context, req resource.UpdateReques
 		payload.BaseJobTemplate = &baseJobTemplate
 	}
 
+	storageConfiguration, diags := workPoolStorageConfigurationFromModel(plan.StorageConfiguration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	payload.StorageConfiguration = storageConfiguration
+
+	// The storage configuration is left as-is when omitted from the payload,
+	// so an empty one is sent to clear it when it is removed from the configuration.
+	if plan.StorageConfiguration == nil && state.StorageConfiguration != nil {
+		payload.StorageConfiguration = &api.WorkPoolStorageConfiguration{}
+	}
+
 	err = client.Update(ctx, plan.Name.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "update", err))
@@ -369,6 +476,15 @@ func (r *WorkPoolResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkPools(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
@@ -383,6 +499,17 @@ func (r *WorkPoolResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// Prefect removes work pools and their work queues asynchronously,
+	// so wait until the work pool is gone before reporting success.
+	err = helpers.WaitForResourceDeletion(ctx, func(ctx context.Context) (*api.WorkPool, error) {
+		return client.Get(ctx, state.Name.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "delete", err))
+
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
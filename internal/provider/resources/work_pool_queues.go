@@ -0,0 +1,965 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+var (
+	_ = resource.ResourceWithConfigure(&WorkPoolQueuesResource{})
+	_ = resource.ResourceWithImportState(&WorkPoolQueuesResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkPoolQueuesResource{})
+	_ = resource.ResourceWithValidateConfig(&WorkPoolQueuesResource{})
+)
+
+// WorkPoolQueuesResource contains state for the resource.
+type WorkPoolQueuesResource struct {
+	client api.PrefectClient
+}
+
+// WorkPoolQueuesResourceModel defines the Terraform resource model.
+type WorkPoolQueuesResourceModel struct {
+	// ID mirrors the work pool ID, as the resource owns
+	// the work pool's entire set of queues.
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	AccountID   customtypes.UUIDValue `tfsdk:"account_id"`
+	WorkspaceID customtypes.UUIDValue `tfsdk:"workspace_id"`
+
+	WorkPoolName          types.String `tfsdk:"work_pool_name"`
+	DeleteUnmanagedQueues types.Bool   `tfsdk:"delete_unmanaged_queues"`
+	ManageDefaultQueue    types.Bool   `tfsdk:"manage_default_queue"`
+
+	// Queues is an ordered list of WorkPoolQueuesQueueModel.
+	Queues types.List `tfsdk:"queues"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// WorkPoolQueuesQueueModel defines a single queue in the `queues` list.
+type WorkPoolQueuesQueueModel struct {
+	ID customtypes.UUIDValue `tfsdk:"id"`
+
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	IsPaused         types.Bool   `tfsdk:"is_paused"`
+	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
+	Priority         types.Int64  `tfsdk:"priority"`
+}
+
+// workPoolQueuesQueueAttrTypes are the attribute types of
+// a single element in the `queues` list.
+var workPoolQueuesQueueAttrTypes = map[string]attr.Type{
+	"id":                customtypes.UUIDType{},
+	"name":              types.StringType,
+	"description":       types.StringType,
+	"is_paused":         types.BoolType,
+	"concurrency_limit": types.Int64Type,
+	"priority":          types.Int64Type,
+}
+
+// NewWorkPoolQueuesResource returns a new WorkPoolQueuesResource.
+//
+//nolint:ireturn // required by Terraform API
+func NewWorkPoolQueuesResource() resource.Resource {
+	return &WorkPoolQueuesResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *WorkPoolQueuesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_work_pool_queues"
+}
+
+// Configure initializes runtime state for the resource.
+func (r *WorkPoolQueuesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(api.PrefectClient)
+	if !ok {
+		resp.Diagnostics.Append(helpers.ConfigureTypeErrorDiagnostic("resource", req.ProviderData))
+
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *WorkPoolQueuesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: helpers.DescriptionWithPlans(`
+The resource 'work_pool_queues' authoritatively manages the ordered list of queues for a work pool.
+<br>
+The priority of each queue is derived from its position in the `+"`queues`"+` list, the first queue having the highest priority.
+Unless `+"`manage_default_queue`"+` is set, the queues come right after the work pool's default queue, whose priority is left unchanged.
+<br>
+Queues that are not in the list, such as those created by workers or `+"`prefect deploy`"+`, are left as-is unless
+`+"`delete_unmanaged_queues`"+` is set, in which case they are reported as drift and deleted on the next apply.
+The work pool's default queue is never modified or deleted unless `+"`manage_default_queue`"+` is set.
+<br>
+Do not use this resource together with `+"`prefect_work_queue`"+` for the same work pool, as the two will conflict.
+<br>
+For more information, see [work queues](https://docs.prefect.io/v3/deploy/infrastructure-concepts/work-pools#work-queues).
+`,
+			helpers.AllPlans...,
+		),
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "Work pool ID (UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account ID (UUID), defaults to the account set in the provider",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Workspace ID (UUID), defaults to the workspace set in the provider",
+				CustomType:  customtypes.UUIDType{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"work_pool_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the work pool whose queues are managed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_unmanaged_queues": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to delete the queues of the work pool that are not in the `queues` list. The work pool's default queue is never deleted.",
+			},
+			"manage_default_queue": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether the work pool's default queue may be included in the `queues` list, to manage its settings and priority. " +
+					"When not set, the queues are given the priorities that follow the default queue's, so that its priority is left unchanged.",
+			},
+			"queues": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Queues of the work pool, ordered from the highest to the lowest priority. Queue names must be unique.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							CustomType:  customtypes.UUIDType{},
+							Description: "Work queue ID (UUID)",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the work queue",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Description of the work queue",
+							Default:     stringdefault.StaticString(""), // Because prefect returns this as the default for none provided
+						},
+						"is_paused": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether this work queue is paused",
+						},
+						"concurrency_limit": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The concurrency limit applied to this work queue",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The priority of this work queue, derived from its position in the `queues` list (1 is the highest), and offset by the default queue's priority unless `manage_default_queue` is set",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig rejects duplicate queue names.
+func (r *WorkPoolQueuesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WorkPoolQueuesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queues, diags := workPoolQueuesFromModel(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(queues))
+	for i, queue := range queues {
+		if queue.Name.IsNull() || queue.Name.IsUnknown() {
+			continue
+		}
+
+		name := queue.Name.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("queues").AtListIndex(i).AtName("name"),
+				"Duplicate work queue name",
+				fmt.Sprintf("Work queue %q is listed more than once. Queue names must be unique within a work pool.", name),
+			)
+		}
+
+		seen[name] = true
+	}
+}
+
+// ModifyPlan derives the priority of each queue from its position in the list,
+// and keeps the IDs of the queues that already exist.
+func (r *WorkPoolQueuesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed, or the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan WorkPoolQueuesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Queues.IsUnknown() {
+		return
+	}
+
+	planned, diags := workPoolQueuesFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idsByName := map[string]customtypes.UUIDValue{}
+	unchanged := false
+	var known []WorkPoolQueuesQueueModel
+	if !req.State.Raw.IsNull() {
+		var state WorkPoolQueuesResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		known, diags = workPoolQueuesFromModel(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, queue := range known {
+			idsByName[queue.Name.ValueString()] = queue.ID
+		}
+
+		unchanged = plan.ManageDefaultQueue.Equal(state.ManageDefaultQueue) && workPoolQueuesSettingsEqual(planned, known)
+	}
+
+	// Only look up the default queue's priority when the queues or the default queue
+	// setting have changed, so that plans without changes don't issue additional API requests.
+	offset := types.Int64Value(0)
+	if stateOffset, ok := workPoolQueuesPriorityOffsetFromModel(known); ok && unchanged {
+		offset = types.Int64Value(stateOffset)
+	} else if len(planned) > 0 {
+		var offsetDiags diag.Diagnostics
+		offset, offsetDiags = r.planWorkPoolQueuesPriorityOffset(ctx, plan)
+		resp.Diagnostics.Append(offsetDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for i := range planned {
+		planned[i].Priority = types.Int64Unknown()
+		if !offset.IsUnknown() {
+			planned[i].Priority = types.Int64Value(offset.ValueInt64() + int64(i+1))
+		}
+
+		if id, ok := idsByName[planned[i].Name.ValueString()]; ok && !planned[i].Name.IsUnknown() {
+			planned[i].ID = id
+		} else {
+			planned[i].ID = customtypes.NewUUIDUnknown()
+		}
+	}
+
+	queues, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workPoolQueuesQueueAttrTypes}, planned)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("queues"), queues)...)
+}
+
+// workPoolQueuesPriorityOffsetFromModel returns the offset of the priorities of
+// the queues in a plan or state, derived from the priority of the first queue.
+// It returns false when there are no queues, or their priorities are not known.
+func workPoolQueuesPriorityOffsetFromModel(queues []WorkPoolQueuesQueueModel) (int64, bool) {
+	if len(queues) == 0 || queues[0].Priority.IsNull() || queues[0].Priority.IsUnknown() {
+		return 0, false
+	}
+
+	return queues[0].Priority.ValueInt64() - 1, true
+}
+
+// workPoolQueuesSettingsEqual reports whether both lists hold the same queues with
+// the same settings, in the same order. The computed ID and priority are ignored.
+func workPoolQueuesSettingsEqual(a, b []WorkPoolQueuesQueueModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Name.Equal(b[i].Name) ||
+			!a[i].Description.Equal(b[i].Description) ||
+			!a[i].IsPaused.Equal(b[i].IsPaused) ||
+			!a[i].ConcurrencyLimit.Equal(b[i].ConcurrencyLimit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// planWorkPoolQueuesPriorityOffset returns the offset of the planned priorities,
+// which is unknown until the work pool and the default queue setting are known.
+func (r *WorkPoolQueuesResource) planWorkPoolQueuesPriorityOffset(ctx context.Context, plan WorkPoolQueuesResourceModel) (types.Int64, diag.Diagnostics) {
+	if plan.ManageDefaultQueue.IsUnknown() || plan.WorkPoolName.IsUnknown() || plan.AccountID.IsUnknown() || plan.WorkspaceID.IsUnknown() {
+		return types.Int64Unknown(), nil
+	}
+
+	if plan.ManageDefaultQueue.ValueBool() {
+		return types.Int64Value(0), nil
+	}
+
+	pool, queues, diags := r.readWorkPoolQueues(ctx, plan, "plan")
+	if diags.HasError() {
+		return types.Int64Unknown(), diags
+	}
+
+	// The work pool is created in the same apply, with a default queue of priority 1.
+	if pool == nil {
+		return types.Int64Value(1), diags
+	}
+
+	return types.Int64Value(workPoolQueuesPriorityOffset(queues, pool.DefaultQueueID, false)), diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WorkPoolQueuesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WorkPoolQueuesResourceModel
+
+	// Populate the model from resource configuration and emit diagnostics on error
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WorkPoolQueuesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WorkPoolQueuesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// On import, only the work pool name is set.
+	isImport := state.Queues.IsNull()
+	if state.DeleteUnmanagedQueues.IsNull() {
+		state.DeleteUnmanagedQueues = types.BoolValue(false)
+	}
+	if state.ManageDefaultQueue.IsNull() {
+		state.ManageDefaultQueue = types.BoolValue(false)
+	}
+
+	pool, queues, diags := r.readWorkPoolQueues(ctx, state, "read")
+	if pool == nil && !diags.HasError() {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known, diags := workPoolQueuesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := make(map[string]bool, len(known))
+	for _, queue := range known {
+		managed[queue.Name.ValueString()] = true
+	}
+
+	// Report queues that Terraform does not manage. When they are to be deleted,
+	// they are kept in state so that the plan shows their removal.
+	// Skipped on import, where every queue is unknown to Terraform.
+	unmanaged := unmanagedWorkPoolQueueNames(queues, managed, pool.DefaultQueueID)
+	if !isImport && state.DeleteUnmanagedQueues.ValueBool() && len(unmanaged) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unmanaged work queues",
+			fmt.Sprintf(
+				"Work pool %s has queues that are not managed by Terraform: %s. They will be deleted on the next apply.",
+				state.WorkPoolName.ValueString(),
+				strings.Join(unmanaged, ", "),
+			),
+		)
+	}
+
+	included := make([]*api.WorkQueue, 0, len(queues))
+	for _, queue := range queues {
+		if queue.ID == pool.DefaultQueueID {
+			if state.ManageDefaultQueue.ValueBool() && managed[queue.Name] {
+				included = append(included, queue)
+			}
+
+			continue
+		}
+
+		if isImport || managed[queue.Name] || state.DeleteUnmanagedQueues.ValueBool() {
+			included = append(included, queue)
+		}
+	}
+
+	state.ID = customtypes.NewUUIDValue(pool.ID)
+
+	resp.Diagnostics.Append(copyWorkPoolQueuesToModel(ctx, sortWorkPoolQueues(included), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *WorkPoolQueuesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WorkPoolQueuesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the managed queues and removes the Terraform state on success.
+// The work pool's default queue is never deleted.
+func (r *WorkPoolQueuesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkPoolQueuesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	pool, queues, diags := r.readWorkPoolQueues(ctx, state, "delete")
+	if pool == nil && !diags.HasError() {
+		// The work pool, and therefore its queues, no longer exist.
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known, diags := workPoolQueuesFromModel(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := make(map[string]bool, len(known))
+	for _, queue := range known {
+		managed[queue.Name.ValueString()] = true
+	}
+
+	client, err := r.client.WorkQueues(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID(), state.WorkPoolName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return
+	}
+
+	for _, queue := range sortWorkPoolQueues(queues) {
+		if queue.ID == pool.DefaultQueueID || !managed[queue.Name] {
+			continue
+		}
+
+		err = client.Delete(ctx, queue.Name)
+		if err != nil && !helpers.Is404Error(err) {
+			resp.Diagnostics.Append(helpers.ResourceClientErrorDiagnostic("Work Pool Queues", "delete", err))
+
+			return
+		}
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *WorkPoolQueuesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateByWorkPoolName(ctx, req, resp)
+}
+
+// readWorkPoolQueues returns the work pool and all of its queues.
+// A nil work pool without error diagnostics means that the work pool was not found.
+func (r *WorkPoolQueuesResource) readWorkPoolQueues(ctx context.Context, model WorkPoolQueuesResourceModel, operation string) (*api.WorkPool, []*api.WorkQueue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	poolClient, err := r.client.WorkPools(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Pool", err))
+
+		return nil, nil, diags
+	}
+
+	pool, err := poolClient.Get(ctx, model.WorkPoolName.ValueString())
+	if err != nil {
+		if helpers.Is404Error(err) {
+			return nil, nil, diags
+		}
+
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Work Pool", "get", err))
+
+		return nil, nil, diags
+	}
+
+	queueClient, err := r.client.WorkQueues(model.AccountID.ValueUUID(), model.WorkspaceID.ValueUUID(), model.WorkPoolName.ValueString())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return nil, nil, diags
+	}
+
+	queues, err := queueClient.List(ctx, api.WorkQueueFilter{})
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Work Pool Queues", operation, err))
+
+		return nil, nil, diags
+	}
+
+	return pool, queues, diags
+}
+
+// apply reconciles the work pool's queues with the planned queues,
+// then copies the resulting queues back into the plan.
+func (r *WorkPoolQueuesResource) apply(ctx context.Context, plan *WorkPoolQueuesResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pool, queues, readDiags := r.readWorkPoolQueues(ctx, *plan, operation)
+	diags.Append(readDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if pool == nil {
+		diags.AddAttributeError(
+			path.Root("work_pool_name"),
+			"Work pool not found",
+			fmt.Sprintf("Could not find work pool %q.", plan.WorkPoolName.ValueString()),
+		)
+
+		return diags
+	}
+
+	planned, modelDiags := workPoolQueuesFromModel(ctx, *plan)
+	diags.Append(modelDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	offset := workPoolQueuesPriorityOffset(queues, pool.DefaultQueueID, plan.ManageDefaultQueue.ValueBool())
+
+	// The priorities shown in the plan are applied as-is, as long as they still come
+	// after the default queue. They are only unknown when the work pool was not known
+	// when planning, in which case the current offset is used.
+	if plannedOffset, ok := workPoolQueuesPriorityOffsetFromModel(planned); ok {
+		if plannedOffset < offset {
+			diags.AddAttributeError(
+				path.Root("queues"),
+				"Work queue priorities changed since planning",
+				fmt.Sprintf(
+					"The default queue of work pool %q now has priority %d, so the planned priorities, starting at %d, would shift it. Plan again to update the priorities.",
+					pool.Name,
+					offset,
+					plannedOffset+1,
+				),
+			)
+
+			return diags
+		}
+
+		offset = plannedOffset
+	}
+
+	desired := make([]api.WorkQueueCreate, 0, len(planned))
+	for i, queue := range planned {
+		payload := workPoolQueuePayloadFromModel(queue, offset+int64(i+1))
+
+		for _, existing := range queues {
+			if existing.ID == pool.DefaultQueueID && existing.Name == payload.Name && !plan.ManageDefaultQueue.ValueBool() {
+				diags.AddAttributeError(
+					path.Root("queues").AtListIndex(i).AtName("name"),
+					"Default work queue is not managed",
+					fmt.Sprintf("Work queue %q is the default queue of work pool %q. Set `manage_default_queue = true` to manage it.", payload.Name, pool.Name),
+				)
+
+				return diags
+			}
+		}
+
+		desired = append(desired, payload)
+	}
+
+	changes := diffWorkPoolQueues(desired, queues, pool.DefaultQueueID, plan.DeleteUnmanagedQueues.ValueBool())
+
+	client, err := r.client.WorkQueues(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID(), plan.WorkPoolName.ValueString())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
+
+		return diags
+	}
+
+	// Delete first, so that deleted queues do not shift the priorities of the others.
+	for _, name := range changes.delete {
+		err = client.Delete(ctx, name)
+		if err != nil && !helpers.Is404Error(err) {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Work Pool Queues", operation, err))
+
+			return diags
+		}
+	}
+
+	// Queues are created and updated in priority order, so that the server
+	// keeps them in the planned order when it shifts conflicting priorities.
+	// As they come after the default queue, it is never shifted.
+	for _, change := range changes.upsert {
+		if change.exists {
+			err = client.Update(ctx, change.payload.Name, api.WorkQueueUpdate{
+				Description:      change.payload.Description,
+				IsPaused:         change.payload.IsPaused,
+				ConcurrencyLimit: change.payload.ConcurrencyLimit,
+				Priority:         change.payload.Priority,
+			})
+		} else {
+			_, err = client.Create(ctx, change.payload)
+		}
+
+		if err != nil {
+			diags.Append(helpers.ResourceClientErrorDiagnostic("Work Pool Queues", operation, err))
+
+			return diags
+		}
+	}
+
+	queues, err = client.List(ctx, api.WorkQueueFilter{})
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Work Pool Queues", operation, err))
+
+		return diags
+	}
+
+	queuesByName := make(map[string]*api.WorkQueue, len(queues))
+	for _, queue := range queues {
+		queuesByName[queue.Name] = queue
+	}
+
+	// Only the planned queues are copied back, in the planned order: a queue
+	// created out of band while we were applying would otherwise make the result
+	// inconsistent with the plan. It is picked up as drift on the next refresh instead.
+	applied := make([]*api.WorkQueue, 0, len(desired))
+	for _, payload := range desired {
+		queue, ok := queuesByName[payload.Name]
+		if !ok {
+			diags.AddError(
+				"Work queue not found",
+				fmt.Sprintf("Work queue %q was not found in work pool %q after being applied.", payload.Name, pool.Name),
+			)
+
+			return diags
+		}
+
+		applied = append(applied, queue)
+	}
+
+	plan.ID = customtypes.NewUUIDValue(pool.ID)
+
+	diags.Append(copyWorkPoolQueuesToModel(ctx, applied, plan)...)
+
+	return diags
+}
+
+// workPoolQueuesFromModel returns the queues from the model, in order.
+// A null or unknown `queues` list returns an empty slice.
+func workPoolQueuesFromModel(ctx context.Context, model WorkPoolQueuesResourceModel) ([]WorkPoolQueuesQueueModel, diag.Diagnostics) {
+	queues := []WorkPoolQueuesQueueModel{}
+	if model.Queues.IsNull() || model.Queues.IsUnknown() {
+		return queues, nil
+	}
+
+	diags := model.Queues.ElementsAs(ctx, &queues, false)
+
+	return queues, diags
+}
+
+// workPoolQueuesPriorityOffset returns the offset of the managed queues' priorities.
+// Unless the default queue is managed, the queues come right after it, so that the
+// server never shifts the default queue's priority to resolve a conflict.
+func workPoolQueuesPriorityOffset(queues []*api.WorkQueue, defaultQueueID uuid.UUID, manageDefaultQueue bool) int64 {
+	if manageDefaultQueue {
+		return 0
+	}
+
+	for _, queue := range queues {
+		if queue.ID == defaultQueueID && queue.Priority != nil {
+			return *queue.Priority
+		}
+	}
+
+	return 0
+}
+
+// workPoolQueuePayloadFromModel builds the API payload for a planned queue
+// with the given priority.
+func workPoolQueuePayloadFromModel(model WorkPoolQueuesQueueModel, priority int64) api.WorkQueueCreate {
+	payload := api.WorkQueueCreate{
+		Name:             model.Name.ValueString(),
+		Description:      new(model.Description.ValueString()),
+		IsPaused:         new(model.IsPaused.ValueBool()),
+		ConcurrencyLimit: model.ConcurrencyLimit.ValueInt64Pointer(),
+		Priority:         new(priority),
+	}
+
+	return payload
+}
+
+// workPoolQueueUpsert is a pending creation of, or update to, a queue.
+type workPoolQueueUpsert struct {
+	exists  bool
+	payload api.WorkQueueCreate
+}
+
+// workPoolQueuesDiff is the set of changes needed to reconcile
+// a work pool's queues with the desired queues.
+type workPoolQueuesDiff struct {
+	upsert []workPoolQueueUpsert
+	delete []string
+}
+
+// diffWorkPoolQueues computes the changes to turn the existing queues into the
+// desired queues. Upserts keep the order of the desired queues, and deletions are
+// sorted by name. When deleteUnmanaged is set, existing queues that are not desired
+// are deleted, except for the default queue.
+func diffWorkPoolQueues(desired []api.WorkQueueCreate, existing []*api.WorkQueue, defaultQueueID uuid.UUID, deleteUnmanaged bool) workPoolQueuesDiff {
+	var changes workPoolQueuesDiff
+
+	existingByName := make(map[string]*api.WorkQueue, len(existing))
+	for _, queue := range existing {
+		existingByName[queue.Name] = queue
+	}
+
+	desiredNames := make(map[string]bool, len(desired))
+	for _, payload := range desired {
+		desiredNames[payload.Name] = true
+
+		queue, ok := existingByName[payload.Name]
+		if !ok {
+			changes.upsert = append(changes.upsert, workPoolQueueUpsert{payload: payload})
+
+			continue
+		}
+
+		if workPoolQueueChanged(payload, queue) {
+			changes.upsert = append(changes.upsert, workPoolQueueUpsert{exists: true, payload: payload})
+		}
+	}
+
+	if deleteUnmanaged {
+		for _, queue := range existing {
+			if queue.ID != defaultQueueID && !desiredNames[queue.Name] {
+				changes.delete = append(changes.delete, queue.Name)
+			}
+		}
+
+		sort.Strings(changes.delete)
+	}
+
+	return changes
+}
+
+// workPoolQueueChanged reports whether applying the payload would change the existing queue.
+func workPoolQueueChanged(payload api.WorkQueueCreate, queue *api.WorkQueue) bool {
+	switch {
+	case payload.Description != nil && *payload.Description != types.StringPointerValue(queue.Description).ValueString(),
+		payload.IsPaused != nil && *payload.IsPaused != queue.IsPaused,
+		(payload.ConcurrencyLimit == nil) != (queue.ConcurrencyLimit == nil),
+		payload.ConcurrencyLimit != nil && *payload.ConcurrencyLimit != *queue.ConcurrencyLimit,
+		payload.Priority != nil && (queue.Priority == nil || *payload.Priority != *queue.Priority):
+		return true
+	}
+
+	return false
+}
+
+// unmanagedWorkPoolQueueNames returns the sorted names of the queues that are not
+// managed, excluding the default queue.
+func unmanagedWorkPoolQueueNames(queues []*api.WorkQueue, managed map[string]bool, defaultQueueID uuid.UUID) []string {
+	unmanaged := []string{}
+	for _, queue := range queues {
+		if queue.ID != defaultQueueID && !managed[queue.Name] {
+			unmanaged = append(unmanaged, queue.Name)
+		}
+	}
+
+	sort.Strings(unmanaged)
+
+	return unmanaged
+}
+
+// sortWorkPoolQueues returns the queues sorted from the highest to the lowest
+// priority (lowest value first), then by name. Queues without a priority come last.
+func sortWorkPoolQueues(queues []*api.WorkQueue) []*api.WorkQueue {
+	sorted := append([]*api.WorkQueue{}, queues...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		left, right := sorted[i].Priority, sorted[j].Priority
+		switch {
+		case left == nil && right == nil:
+			return sorted[i].Name < sorted[j].Name
+		case left == nil:
+			return false
+		case right == nil:
+			return true
+		case *left != *right:
+			return *left < *right
+		}
+
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
+
+// copyWorkPoolQueuesToModel maps the ordered queues onto the model. The priority of
+// each queue is its position in the list, rather than the value stored by the server,
+// which may be shifted by queues that are not managed.
+func copyWorkPoolQueuesToModel(ctx context.Context, queues []*api.WorkQueue, model *WorkPoolQueuesResourceModel) diag.Diagnostics {
+	elements := make([]WorkPoolQueuesQueueModel, 0, len(queues))
+	for i, queue := range queues {
+		elements = append(elements, WorkPoolQueuesQueueModel{
+			ID:               customtypes.NewUUIDValue(queue.ID),
+			Name:             types.StringValue(queue.Name),
+			Description:      types.StringValue(types.StringPointerValue(queue.Description).ValueString()),
+			IsPaused:         types.BoolValue(queue.IsPaused),
+			ConcurrencyLimit: types.Int64PointerValue(queue.ConcurrencyLimit),
+			Priority:         types.Int64Value(int64(i + 1)),
+		})
+	}
+
+	queuesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workPoolQueuesQueueAttrTypes}, elements)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.Queues = queuesList
+
+	return diags
+}
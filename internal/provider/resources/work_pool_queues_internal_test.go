@@ -0,0 +1,170 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffWorkPoolQueues(t *testing.T) {
+	t.Parallel()
+
+	defaultQueueID := uuid.New()
+
+	existing := []*api.WorkQueue{
+		{BaseModel: api.BaseModel{ID: defaultQueueID}, Name: "default", Description: new(""), Priority: new(int64(1))},
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "critical", Description: new(""), Priority: new(int64(2))},
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "batch", Description: new(""), Priority: new(int64(3))},
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "from-worker", Description: new(""), Priority: new(int64(4))},
+	}
+
+	desired := []api.WorkQueueCreate{
+		// Unchanged.
+		{Name: "critical", Description: new(""), IsPaused: new(false), Priority: new(int64(2))},
+		// New.
+		{Name: "reports", Description: new(""), IsPaused: new(false), Priority: new(int64(3))},
+		// Changed: moved after the new queue and limited.
+		{Name: "batch", Description: new(""), IsPaused: new(false), ConcurrencyLimit: new(int64(5)), Priority: new(int64(4))},
+	}
+
+	changes := diffWorkPoolQueues(desired, existing, defaultQueueID, false)
+
+	assert.Equal(t, []workPoolQueueUpsert{
+		{exists: false, payload: desired[1]},
+		{exists: true, payload: desired[2]},
+	}, changes.upsert)
+	assert.Empty(t, changes.delete)
+
+	// The default queue is never deleted.
+	changes = diffWorkPoolQueues(desired, existing, defaultQueueID, true)
+
+	assert.Equal(t, []string{"from-worker"}, changes.delete)
+}
+
+func TestWorkPoolQueueChanged(t *testing.T) {
+	t.Parallel()
+
+	queue := &api.WorkQueue{Name: "critical", Description: nil, IsPaused: false, ConcurrencyLimit: new(int64(5)), Priority: new(int64(1))}
+
+	tests := []struct {
+		name    string
+		payload api.WorkQueueCreate
+		want    bool
+	}{
+		{"unchanged", api.WorkQueueCreate{Description: new(""), IsPaused: new(false), ConcurrencyLimit: new(int64(5)), Priority: new(int64(1))}, false},
+		{"description", api.WorkQueueCreate{Description: new("critical jobs"), IsPaused: new(false), ConcurrencyLimit: new(int64(5)), Priority: new(int64(1))}, true},
+		{"paused", api.WorkQueueCreate{Description: new(""), IsPaused: new(true), ConcurrencyLimit: new(int64(5)), Priority: new(int64(1))}, true},
+		{"concurrency limit removed", api.WorkQueueCreate{Description: new(""), IsPaused: new(false), Priority: new(int64(1))}, true},
+		{"concurrency limit changed", api.WorkQueueCreate{Description: new(""), IsPaused: new(false), ConcurrencyLimit: new(int64(10)), Priority: new(int64(1))}, true},
+		{"priority", api.WorkQueueCreate{Description: new(""), IsPaused: new(false), ConcurrencyLimit: new(int64(5)), Priority: new(int64(2))}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, workPoolQueueChanged(tc.payload, queue))
+		})
+	}
+}
+
+func TestSortWorkPoolQueues(t *testing.T) {
+	t.Parallel()
+
+	queues := []*api.WorkQueue{
+		{Name: "unprioritized"},
+		{Name: "low", Priority: new(int64(10))},
+		{Name: "high-b", Priority: new(int64(1))},
+		{Name: "high-a", Priority: new(int64(1))},
+	}
+
+	names := []string{}
+	for _, queue := range sortWorkPoolQueues(queues) {
+		names = append(names, queue.Name)
+	}
+
+	assert.Equal(t, []string{"high-a", "high-b", "low", "unprioritized"}, names)
+	assert.Equal(t, "unprioritized", queues[0].Name, "the input must not be reordered")
+}
+
+func TestUnmanagedWorkPoolQueueNames(t *testing.T) {
+	t.Parallel()
+
+	defaultQueueID := uuid.New()
+
+	queues := []*api.WorkQueue{
+		{BaseModel: api.BaseModel{ID: defaultQueueID}, Name: "default"},
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "managed"},
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "zeta"},
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "alpha"},
+	}
+
+	unmanaged := unmanagedWorkPoolQueueNames(queues, map[string]bool{"managed": true}, defaultQueueID)
+
+	assert.Equal(t, []string{"alpha", "zeta"}, unmanaged)
+}
+
+func TestWorkPoolQueuesPriorityOffset(t *testing.T) {
+	t.Parallel()
+
+	defaultQueueID := uuid.New()
+
+	queues := []*api.WorkQueue{
+		{BaseModel: api.BaseModel{ID: uuid.New()}, Name: "from-worker", Priority: new(int64(1))},
+		{BaseModel: api.BaseModel{ID: defaultQueueID}, Name: "default", Priority: new(int64(2))},
+	}
+
+	// The managed queues come right after the default queue, so it is never shifted.
+	assert.Equal(t, int64(2), workPoolQueuesPriorityOffset(queues, defaultQueueID, false))
+
+	// Once managed, the default queue is ordered with the others.
+	assert.Equal(t, int64(0), workPoolQueuesPriorityOffset(queues, defaultQueueID, true))
+
+	// Without a default queue, the priorities start at 1.
+	assert.Equal(t, int64(0), workPoolQueuesPriorityOffset(queues[:1], defaultQueueID, false))
+}
+
+func TestWorkPoolQueuesPriorityOffsetFromModel(t *testing.T) {
+	t.Parallel()
+
+	queues := []WorkPoolQueuesQueueModel{
+		{Name: types.StringValue("high"), Priority: types.Int64Value(2)},
+		{Name: types.StringValue("low"), Priority: types.Int64Value(3)},
+	}
+
+	offset, ok := workPoolQueuesPriorityOffsetFromModel(queues)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), offset)
+
+	_, ok = workPoolQueuesPriorityOffsetFromModel([]WorkPoolQueuesQueueModel{{Name: types.StringValue("high"), Priority: types.Int64Unknown()}})
+	assert.False(t, ok)
+
+	_, ok = workPoolQueuesPriorityOffsetFromModel(nil)
+	assert.False(t, ok)
+}
+
+func TestWorkPoolQueuesSettingsEqual(t *testing.T) {
+	t.Parallel()
+
+	newQueue := func(name string, isPaused bool) WorkPoolQueuesQueueModel {
+		return WorkPoolQueuesQueueModel{
+			Name:             types.StringValue(name),
+			Description:      types.StringValue(""),
+			IsPaused:         types.BoolValue(isPaused),
+			ConcurrencyLimit: types.Int64Null(),
+		}
+	}
+
+	known := []WorkPoolQueuesQueueModel{newQueue("high", false), newQueue("low", false)}
+	known[0].Priority = types.Int64Value(2)
+
+	// The computed priority is ignored.
+	assert.True(t, workPoolQueuesSettingsEqual([]WorkPoolQueuesQueueModel{newQueue("high", false), newQueue("low", false)}, known))
+
+	assert.False(t, workPoolQueuesSettingsEqual([]WorkPoolQueuesQueueModel{newQueue("low", false), newQueue("high", false)}, known))
+	assert.False(t, workPoolQueuesSettingsEqual([]WorkPoolQueuesQueueModel{newQueue("high", true), newQueue("low", false)}, known))
+	assert.False(t, workPoolQueuesSettingsEqual([]WorkPoolQueuesQueueModel{newQueue("high", false)}, known))
+}
@@ -0,0 +1,268 @@
+package resources_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/testutils"
+)
+
+const workPoolQueuesResourceName = "prefect_work_pool_queues.test"
+
+type workPoolQueuesFixtureConfig struct {
+	WorkspaceResource     string
+	WorkspaceIDArg        string
+	WorkPoolName          string
+	Queues                []string
+	DeleteUnmanagedQueues bool
+}
+
+func fixtureAccWorkPoolQueues(cfg workPoolQueuesFixtureConfig) string {
+	tmpl := `
+{{.WorkspaceResource}}
+
+resource "prefect_work_pool" "test" {
+	name = "{{.WorkPoolName}}"
+	type = "process"
+	{{.WorkspaceIDArg}}
+}
+
+resource "prefect_work_pool_queues" "test" {
+	work_pool_name = prefect_work_pool.test.name
+	delete_unmanaged_queues = {{.DeleteUnmanagedQueues}}
+	{{.WorkspaceIDArg}}
+
+	queues = [
+		{{- range .Queues}}
+		{
+			name = "{{.}}"
+			{{- if eq . "batch"}}
+			concurrency_limit = 5
+			is_paused = true
+			{{- end}}
+		},
+		{{- end}}
+	]
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_queues(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	cfg := workPoolQueuesFixtureConfig{
+		WorkspaceResource: workspace.Resource,
+		WorkspaceIDArg:    workspace.IDArg,
+		WorkPoolName:      testutils.NewRandomPrefixedString(),
+		Queues:            []string{"critical", "batch"},
+	}
+
+	cfgReordered := cfg
+	cfgReordered.Queues = []string{"reports", "batch", "critical"}
+	cfgReordered.DeleteUnmanagedQueues = true
+
+	cfgDuplicate := cfg
+	cfgDuplicate.Queues = []string{"critical", "critical"}
+
+	cfgDefaultQueue := cfg
+	cfgDefaultQueue.Queues = []string{"default"}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Check that duplicate queue names are rejected
+				Config:      fixtureAccWorkPoolQueues(cfgDuplicate),
+				ExpectError: regexp.MustCompile(`Duplicate work queue name`),
+			},
+			{
+				// Check that the default queue cannot be managed without opting in
+				Config:      fixtureAccWorkPoolQueues(cfgDefaultQueue),
+				ExpectError: regexp.MustCompile(`Default work queue is not managed`),
+			},
+			{
+				// Check creation of the queues, after the default queue which is left as-is
+				Config: fixtureAccWorkPoolQueues(cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolQueueNames(workPoolQueuesResourceName, []string{"critical", "batch"}),
+					testAccCheckDefaultWorkQueuePriority(workPoolQueuesResourceName, 1),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.CompareValuePairs(workPoolQueuesResourceName, "id", "prefect_work_pool.test", "id"),
+					testutils.ExpectKnownValueListSize(workPoolQueuesResourceName, "queues", 2),
+					testutils.ExpectKnownValue(workPoolQueuesResourceName, "queues.0.name", "critical"),
+					testutils.ExpectKnownValueNumber(workPoolQueuesResourceName, "queues.0.priority", 2),
+					testutils.ExpectKnownValue(workPoolQueuesResourceName, "queues.1.name", "batch"),
+					testutils.ExpectKnownValueNumber(workPoolQueuesResourceName, "queues.1.priority", 3),
+					testutils.ExpectKnownValueNumber(workPoolQueuesResourceName, "queues.1.concurrency_limit", 5),
+					testutils.ExpectKnownValueBool(workPoolQueuesResourceName, "queues.1.is_paused", true),
+				},
+			},
+			{
+				// Create a queue out of band, as a worker would: it is deleted on apply,
+				// while the queues are reordered and a new one is added.
+				PreConfig: func() {
+					testAccCreateUnmanagedWorkQueue(t, cfg.WorkPoolName)
+				},
+				Config: fixtureAccWorkPoolQueues(cfgReordered),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolQueueNames(workPoolQueuesResourceName, []string{"reports", "batch", "critical"}),
+					testAccCheckDefaultWorkQueuePriority(workPoolQueuesResourceName, 1),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueListSize(workPoolQueuesResourceName, "queues", 3),
+					testutils.ExpectKnownValue(workPoolQueuesResourceName, "queues.0.name", "reports"),
+					testutils.ExpectKnownValue(workPoolQueuesResourceName, "queues.1.name", "batch"),
+					testutils.ExpectKnownValue(workPoolQueuesResourceName, "queues.2.name", "critical"),
+					testutils.ExpectKnownValueNumber(workPoolQueuesResourceName, "queues.2.priority", 4),
+				},
+			},
+			{
+				ImportState:  true,
+				ResourceName: workPoolQueuesResourceName,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					workPoolName, err := testutils.GetResourceAttributeFromStateByAttribute(state, workPoolQueuesResourceName, "work_pool_name")
+					if err != nil {
+						return "", fmt.Errorf("unable to get work pool name from state: %w", err)
+					}
+
+					if testutils.TestContextOSS() {
+						return workPoolName, nil
+					}
+
+					workspaceID, err := testutils.GetResourceWorkspaceIDFromState(state)
+					if err != nil {
+						return "", fmt.Errorf("unable to get workspaceID from state: %w", err)
+					}
+
+					return fmt.Sprintf("%s,%s", workPoolName, workspaceID), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_unmanaged_queues"},
+			},
+		},
+	})
+}
+
+// workPoolQueuesWorkspaceID records the workspace of the work pool,
+// captured from state during the test steps.
+var workPoolQueuesWorkspaceID uuid.UUID
+
+// testAccCheckWorkPoolQueueNames checks that, besides the default queue, the work pool's
+// queues on the server have exactly the expected names, in priority order.
+func testAccCheckWorkPoolQueueNames(resourceName string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		workPoolName, err := testutils.GetResourceAttributeFromStateByAttribute(s, resourceName, "work_pool_name")
+		if err != nil {
+			return fmt.Errorf("error fetching work pool name: %w", err)
+		}
+
+		var workspaceID uuid.UUID
+
+		if !testutils.TestContextOSS() {
+			workspaceID, err = testutils.GetResourceIDFromState(s, testutils.WorkspaceResourceName)
+			if err != nil {
+				return fmt.Errorf("error fetching workspace ID: %w", err)
+			}
+		}
+
+		workPoolQueuesWorkspaceID = workspaceID
+
+		c, _ := testutils.NewTestClient()
+		queuesClient, _ := c.WorkQueues(uuid.Nil, workspaceID, workPoolName)
+
+		queues, err := queuesClient.List(context.Background(), api.WorkQueueFilter{})
+		if err != nil {
+			return fmt.Errorf("error fetching work queues: %w", err)
+		}
+
+		// The default queue is not managed.
+		if len(queues) != len(expected)+1 {
+			return fmt.Errorf("expected %d work queues, got %d", len(expected)+1, len(queues))
+		}
+
+		priorities := make(map[string]int64, len(queues))
+		for _, queue := range queues {
+			if queue.Priority != nil {
+				priorities[queue.Name] = *queue.Priority
+			}
+		}
+
+		for i, name := range expected {
+			if _, ok := priorities[name]; !ok {
+				return fmt.Errorf("expected a work queue named %q", name)
+			}
+
+			if i > 0 && priorities[expected[i-1]] >= priorities[name] {
+				return fmt.Errorf("expected work queue %q to have a higher priority than %q", expected[i-1], name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDefaultWorkQueuePriority checks that the work pool's default queue
+// has the expected priority on the server.
+func testAccCheckDefaultWorkQueuePriority(resourceName string, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		workPoolName, err := testutils.GetResourceAttributeFromStateByAttribute(s, resourceName, "work_pool_name")
+		if err != nil {
+			return fmt.Errorf("error fetching work pool name: %w", err)
+		}
+
+		c, _ := testutils.NewTestClient()
+		poolsClient, _ := c.WorkPools(uuid.Nil, workPoolQueuesWorkspaceID)
+
+		pool, err := poolsClient.Get(context.Background(), workPoolName)
+		if err != nil {
+			return fmt.Errorf("error fetching work pool: %w", err)
+		}
+
+		queuesClient, _ := c.WorkQueues(uuid.Nil, workPoolQueuesWorkspaceID, workPoolName)
+
+		queues, err := queuesClient.List(context.Background(), api.WorkQueueFilter{})
+		if err != nil {
+			return fmt.Errorf("error fetching work queues: %w", err)
+		}
+
+		for _, queue := range queues {
+			if queue.ID != pool.DefaultQueueID {
+				continue
+			}
+
+			if queue.Priority == nil || *queue.Priority != expected {
+				return fmt.Errorf("expected the default work queue to have priority %d, got %v", expected, queue.Priority)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("default work queue of work pool %q not found", workPoolName)
+	}
+}
+
+// testAccCreateUnmanagedWorkQueue creates a work queue outside of Terraform,
+// as a worker or `prefect deploy` would.
+func testAccCreateUnmanagedWorkQueue(t *testing.T, workPoolName string) {
+	t.Helper()
+
+	c, _ := testutils.NewTestClient()
+	queuesClient, _ := c.WorkQueues(uuid.Nil, workPoolQueuesWorkspaceID, workPoolName)
+
+	_, err := queuesClient.Create(context.Background(), api.WorkQueueCreate{Name: "created-outside-terraform"})
+	if err != nil {
+		t.Fatalf("error creating unmanaged work queue: %s", err)
+	}
+}
@@ -0,0 +1,176 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
+	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
+)
+
+// resultStorageCapabilities are the block capabilities required
+// for a block to be used as the default result storage.
+var resultStorageCapabilities = []string{"read-path", "write-path"}
+
+// WorkPoolStorageConfigurationModel defines the storage configuration of a work pool.
+type WorkPoolStorageConfigurationModel struct {
+	BundleUploadStep            jsontypes.Normalized  `tfsdk:"bundle_upload_step"`
+	BundleExecutionStep         jsontypes.Normalized  `tfsdk:"bundle_execution_step"`
+	DefaultResultStorageBlockID customtypes.UUIDValue `tfsdk:"default_result_storage_block_id"`
+}
+
+// workPoolStorageConfigurationAttribute returns the schema of the `storage_configuration` attribute.
+func workPoolStorageConfigurationAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Storage configuration of the work pool, used to upload and execute the code bundles of flows submitted to the work pool, and to persist their results.",
+		Attributes: map[string]schema.Attribute{
+			"bundle_upload_step": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Step to upload flow code bundles, as a JSON string, for example `jsonencode({\"prefect_aws.experimental.bundles.upload\" = { requires = \"prefect-aws\", bucket = \"my-bucket\", aws_credentials_block_name = \"my-credentials\" }})`",
+			},
+			"bundle_execution_step": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "Step to download and execute flow code bundles, as a JSON string, for example `jsonencode({\"prefect_aws.experimental.bundles.execute\" = { requires = \"prefect-aws\", aws_credentials_block_name = \"my-credentials\" }})`",
+			},
+			"default_result_storage_block_id": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  customtypes.UUIDType{},
+				Description: "ID (UUID) of the block used to persist the results of flows submitted to the work pool. The block must be a storage block that supports reading and writing paths, such as `s3-bucket` or `local-file-system`.",
+			},
+		},
+	}
+}
+
+// workPoolStorageConfigurationFromModel converts the storage configuration model to its API representation.
+func workPoolStorageConfigurationFromModel(model *WorkPoolStorageConfigurationModel) (*api.WorkPoolStorageConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model == nil {
+		return nil, diags
+	}
+
+	bundleUploadStep, diags := helpers.UnmarshalOptional(model.BundleUploadStep)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	bundleExecutionStep, diags := helpers.UnmarshalOptional(model.BundleExecutionStep)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &api.WorkPoolStorageConfiguration{
+		BundleUploadStep:            bundleUploadStep,
+		BundleExecutionStep:         bundleExecutionStep,
+		DefaultResultStorageBlockID: model.DefaultResultStorageBlockID.ValueUUIDPointer(),
+	}, diags
+}
+
+// copyWorkPoolStorageConfigurationToModel maps the storage configuration of a work pool to the model.
+// An empty storage configuration is left out of the model, unless it was already set.
+//
+//nolint:ireturn // required to return Diagnostics
+func copyWorkPoolStorageConfigurationToModel(storage *api.WorkPoolStorageConfiguration, tfModel *WorkPoolResourceModel) diag.Diagnostic {
+	isEmpty := storage == nil ||
+		(storage.BundleUploadStep == nil && storage.BundleExecutionStep == nil && storage.DefaultResultStorageBlockID == nil)
+
+	if isEmpty && tfModel.StorageConfiguration == nil {
+		return nil
+	}
+
+	if storage == nil {
+		storage = &api.WorkPoolStorageConfiguration{}
+	}
+
+	model := &WorkPoolStorageConfigurationModel{
+		BundleUploadStep:            jsontypes.NewNormalizedNull(),
+		BundleExecutionStep:         jsontypes.NewNormalizedNull(),
+		DefaultResultStorageBlockID: customtypes.NewUUIDPointerValue(storage.DefaultResultStorageBlockID),
+	}
+
+	if storage.BundleUploadStep != nil {
+		byteSlice, err := json.Marshal(storage.BundleUploadStep)
+		if err != nil {
+			return helpers.SerializeDataErrorDiagnostic("storage_configuration.bundle_upload_step", "Work Pool Bundle Upload Step", err)
+		}
+		model.BundleUploadStep = jsontypes.NewNormalizedValue(string(byteSlice))
+	}
+
+	if storage.BundleExecutionStep != nil {
+		byteSlice, err := json.Marshal(storage.BundleExecutionStep)
+		if err != nil {
+			return helpers.SerializeDataErrorDiagnostic("storage_configuration.bundle_execution_step", "Work Pool Bundle Execution Step", err)
+		}
+		model.BundleExecutionStep = jsontypes.NewNormalizedValue(string(byteSlice))
+	}
+
+	tfModel.StorageConfiguration = model
+
+	return nil
+}
+
+// validateWorkPoolStorageConfiguration checks that the default result storage
+// block exists, and can be used as result storage.
+func validateWorkPoolStorageConfiguration(
+	ctx context.Context,
+	prefectClient api.PrefectClient,
+	accountID, workspaceID, defaultResultStorageBlockID customtypes.UUIDValue,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	blockPath := path.Root("storage_configuration").AtName("default_result_storage_block_id")
+	blockID := defaultResultStorageBlockID.ValueUUID()
+
+	client, err := prefectClient.BlockDocuments(accountID.ValueUUID(), workspaceID.ValueUUID())
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Block", err))
+
+		return diags
+	}
+
+	block, err := client.Get(ctx, blockID)
+	if err != nil {
+		if helpers.Is404Error(err) {
+			diags.AddAttributeError(
+				blockPath,
+				"Default result storage block not found",
+				fmt.Sprintf("Could not find a block with ID %s to use as the default result storage.", blockID),
+			)
+
+			return diags
+		}
+
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Block", "get", err))
+
+		return diags
+	}
+
+	if block.BlockSchema == nil {
+		return diags
+	}
+
+	for _, capability := range resultStorageCapabilities {
+		if !slices.Contains(block.BlockSchema.Capabilities, capability) {
+			diags.AddAttributeError(
+				blockPath,
+				"Invalid default result storage block",
+				fmt.Sprintf("Block %q (%s) cannot be used as the default result storage: its block type does not have the %q capability.", block.Name, block.BlockType.Slug, capability),
+			)
+
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -137,6 +137,124 @@ func TestAccResource_work_pool(t *testing.T) {
 	})
 }
 
+type workPoolStorageConfigurationFixtureConfig struct {
+	Workspace      string
+	WorkspaceIDArg string
+	WorkPoolName   string
+	BlockTypeSlug  string
+	BlockData      string
+	WithStorage    bool
+}
+
+func fixtureAccWorkPoolStorageConfiguration(cfg workPoolStorageConfigurationFixtureConfig) string {
+	tmpl := `
+{{ .Workspace }}
+
+resource "prefect_block" "results" {
+	name = "{{ .WorkPoolName }}-results"
+	type_slug = "{{ .BlockTypeSlug }}"
+	data = jsonencode({{ .BlockData }})
+	{{ .WorkspaceIDArg }}
+}
+
+resource "prefect_work_pool" "{{ .WorkPoolName }}" {
+	name = "{{ .WorkPoolName }}"
+	type = "process"
+	{{- if .WithStorage }}
+	storage_configuration = {
+		bundle_upload_step = jsonencode({
+			"prefect_aws.experimental.bundles.upload" = {
+				requires = "prefect-aws"
+				bucket = "my-bucket"
+			}
+		})
+		bundle_execution_step = jsonencode({
+			"prefect_aws.experimental.bundles.execute" = {
+				requires = "prefect-aws"
+			}
+		})
+		default_result_storage_block_id = prefect_block.results.id
+	}
+	{{- end }}
+	{{ .WorkspaceIDArg }}
+}
+`
+
+	return testutils.RenderTemplate(tmpl, cfg)
+}
+
+//nolint:paralleltest // we use the resource.ParallelTest helper instead
+func TestAccResource_work_pool_storage_configuration(t *testing.T) {
+	workspace := testutils.NewEphemeralWorkspace()
+
+	randomName := testutils.NewRandomPrefixedString()
+	workPoolResourceName := "prefect_work_pool." + randomName
+
+	cfg := workPoolStorageConfigurationFixtureConfig{
+		Workspace:      workspace.Resource,
+		WorkspaceIDArg: workspace.IDArg,
+		WorkPoolName:   randomName,
+		BlockTypeSlug:  "local-file-system",
+		BlockData:      `{ basepath = "/tmp/results" }`,
+		WithStorage:    true,
+	}
+
+	cfgInvalidBlock := cfg
+	cfgInvalidBlock.BlockTypeSlug = "secret"
+	cfgInvalidBlock.BlockData = `{ value = "not-a-storage-block" }`
+
+	cfgInvalidBlockWithoutStorage := cfgInvalidBlock
+	cfgInvalidBlockWithoutStorage.WithStorage = false
+
+	cfgWithoutStorage := cfg
+	cfgWithoutStorage.WithStorage = false
+
+	var workPool api.WorkPool
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testutils.AccTestPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// Create the block first, so that its ID is known when planning the storage configuration
+				Config: fixtureAccWorkPoolStorageConfiguration(cfgInvalidBlockWithoutStorage),
+			},
+			{
+				// Check that a block without the read-path and write-path capabilities is rejected when planning
+				Config:      fixtureAccWorkPoolStorageConfiguration(cfgInvalidBlock),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)cannot be used as the default result storage`),
+			},
+			{
+				// Check creation of a work pool with a storage configuration
+				Config: fixtureAccWorkPoolStorageConfiguration(cfg),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkPoolExists(workPoolResourceName, &workPool),
+					resource.TestCheckResourceAttrPair(
+						workPoolResourceName, "storage_configuration.default_result_storage_block_id",
+						"prefect_block.results", "id",
+					),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValue(workPoolResourceName, "storage_configuration.bundle_upload_step", `{"prefect_aws.experimental.bundles.upload":{"bucket":"my-bucket","requires":"prefect-aws"}}`),
+					testutils.ExpectKnownValue(workPoolResourceName, "storage_configuration.bundle_execution_step", `{"prefect_aws.experimental.bundles.execute":{"requires":"prefect-aws"}}`),
+				},
+			},
+			{
+				// Check that removing the storage configuration clears it in place
+				Config: fixtureAccWorkPoolStorageConfiguration(cfgWithoutStorage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDAreEqual(workPoolResourceName, &workPool),
+					testAccCheckWorkPoolExists(workPoolResourceName, &workPool),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueNull(workPoolResourceName, "storage_configuration"),
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckWorkPoolExists(workPoolResourceName string, workPool *api.WorkPool) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		workPoolName, err := testutils.GetResourceAttributeFromStateByAttribute(state, workPoolResourceName, "name")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -42,6 +43,8 @@ type WorkQueueResourceModel struct {
 	ConcurrencyLimit types.Int64  `tfsdk:"concurrency_limit"`
 	Priority         types.Int64  `tfsdk:"priority"`
 	WorkPoolName     types.String `tfsdk:"work_pool_name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewWorkQueueResource returns a new WorkQueueResource.
@@ -73,7 +76,7 @@ func (r *WorkQueueResource) Configure(_ context.Context, req resource.ConfigureR
 }
 
 // Schema defines the schema for the resource.
-func (r *WorkQueueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WorkQueueResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `work_queue` represents a Prefect Work Queue. "+
@@ -150,6 +153,9 @@ func (r *WorkQueueResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -176,6 +182,15 @@ func (r *WorkQueueResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkQueues(
 		plan.AccountID.ValueUUID(),
 		plan.WorkspaceID.ValueUUID(),
@@ -224,6 +239,15 @@ func (r *WorkQueueResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkQueues(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID(), state.WorkPoolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
@@ -265,6 +289,15 @@ func (r *WorkQueueResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkQueues(plan.AccountID.ValueUUID(), plan.WorkspaceID.ValueUUID(), plan.WorkPoolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
@@ -318,6 +351,15 @@ func (r *WorkQueueResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkQueues(state.AccountID.ValueUUID(), state.WorkspaceID.ValueUUID(), state.WorkPoolName.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Work Queue", err))
@@ -2,8 +2,12 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/prefecthq/terraform-provider-prefect/internal/api"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/customtypes"
 	"github.com/prefecthq/terraform-provider-prefect/internal/provider/helpers"
@@ -20,6 +25,7 @@ import (
 var (
 	_ = resource.ResourceWithConfigure(&WorkspaceRoleResource{})
 	_ = resource.ResourceWithImportState(&WorkspaceRoleResource{})
+	_ = resource.ResourceWithModifyPlan(&WorkspaceRoleResource{})
 )
 
 // WorkspaceRoleResource contains state for the resource.
@@ -36,6 +42,9 @@ type WorkspaceRoleResourceModel struct {
 	Scopes          types.List            `tfsdk:"scopes"`
 	AccountID       customtypes.UUIDValue `tfsdk:"account_id"`
 	InheritedRoleID customtypes.UUIDValue `tfsdk:"inherited_role_id"`
+	EffectiveScopes types.Set             `tfsdk:"effective_scopes"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewWorkspaceRoleResource returns a new WorkspaceRoleResource.
@@ -66,15 +75,16 @@ func (r *WorkspaceRoleResource) Configure(_ context.Context, req resource.Config
 	r.client = client
 }
 
-func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *WorkspaceRoleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: helpers.DescriptionWithPlans(
 			"The resource `workspace_role` represents a Prefect Cloud Workspace Role. "+
 				"Workspace Roles hold a set of permissions to a specific Workspace, and can be attached to "+
 				"an accessor (User or Service Account) to grant access to the Workspace.\n"+
 				"\n"+
-				"To obtain a list of available scopes, please refer to the `GET /api/workspace_scopes` "+
-				"[API](https://app.prefect.cloud/api/docs#tag/Workspace-Scopes/operation/get_workspace_scopes_api_workspace_scopes_get).\n"+
+				"To obtain a list of available scopes, use the `prefect_workspace_scopes` data source, or refer to the `GET /api/workspace_scopes` "+
+				"[API](https://app.prefect.cloud/api/docs#tag/Workspace-Scopes/operation/get_workspace_scopes_api_workspace_scopes_get). "+
+				"Unknown scopes are rejected during plan.\n"+
 				"\n"+
 				"For more information, see [manage workspaces](https://docs.prefect.io/v3/manage/cloud/workspaces).",
 			helpers.PlanPro,
@@ -114,10 +124,15 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 				Default:     stringdefault.StaticString(""),
 			},
 			"scopes": schema.ListAttribute{
-				Description: "List of scopes linked to the Workspace Role",
+				Description: "List of scopes linked to the Workspace Role. Use the `prefect_workspace_scopes` data source to list the available scopes.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"effective_scopes": schema.SetAttribute{
+				Description: "Full set of scopes granted by the Workspace Role: its own `scopes`, plus the scopes of the role referenced by `inherited_role_id` (and, transitively, the roles it inherits from)",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"account_id": schema.StringAttribute{
 				CustomType:  customtypes.UUIDType{},
 				Description: "Account ID (UUID), defaults to the account set in the provider",
@@ -132,6 +147,9 @@ func (r *WorkspaceRoleResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helpers.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -165,6 +183,169 @@ func copyWorkspaceRoleToModel(_ context.Context, role *api.WorkspaceRole, tfMode
 	return nil
 }
 
+// maxInheritedRoleDepth bounds how many inherited roles are followed when
+// expanding the effective scopes, as a guard against cycles.
+const maxInheritedRoleDepth = 10
+
+// workspaceRoleEffectiveScopes expands a role's scopes with the scopes of the
+// role it inherits from, following the inheritance chain transitively.
+// The scopes returned by the API for inherited roles already include
+// subordinate scopes (e.g. `see_blocks` for `manage_blocks`).
+func workspaceRoleEffectiveScopes(ctx context.Context, client api.WorkspaceRolesClient, scopes []string, inheritedRoleID *uuid.UUID) ([]string, error) {
+	effectiveScopes := slices.Clone(scopes)
+
+	visited := map[uuid.UUID]bool{}
+	for depth := 0; inheritedRoleID != nil && depth < maxInheritedRoleDepth; depth++ {
+		if visited[*inheritedRoleID] {
+			break
+		}
+		visited[*inheritedRoleID] = true
+
+		inheritedRole, err := client.Get(ctx, *inheritedRoleID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get inherited workspace role %s: %w", inheritedRoleID, err)
+		}
+
+		effectiveScopes = append(effectiveScopes, inheritedRole.Scopes...)
+		inheritedRoleID = inheritedRole.InheritedRoleID
+	}
+
+	sort.Strings(effectiveScopes)
+
+	return slices.Compact(effectiveScopes), nil
+}
+
+// setEffectiveScopes computes the effective scopes for the model from its
+// configured scopes and inherited role, and stores them on the model.
+func setEffectiveScopes(ctx context.Context, client api.WorkspaceRolesClient, tfModel *WorkspaceRoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var scopes []string
+	diags.Append(tfModel.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	effectiveScopes, err := workspaceRoleEffectiveScopes(ctx, client, scopes, tfModel.InheritedRoleID.ValueUUIDPointer())
+	if err != nil {
+		diags.Append(helpers.ResourceClientErrorDiagnostic("Workspace Role", "get inherited role", err))
+
+		return diags
+	}
+
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, effectiveScopes)
+	diags.Append(setDiags...)
+	tfModel.EffectiveScopes = set
+
+	return diags
+}
+
+// ModifyPlan validates the configured scopes against the catalogue of
+// workspace scopes, and computes the effective scopes of the role so that
+// reviewers can see the full permission set in the plan.
+func (r *WorkspaceRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy, or if the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan WorkspaceRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Scopes.IsUnknown() || plan.InheritedRoleID.IsUnknown() {
+		return
+	}
+
+	for _, scope := range plan.Scopes.Elements() {
+		if scope.IsUnknown() {
+			return
+		}
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only look up the scope catalogue when the scopes have changed,
+	// so that plans without changes don't issue additional API requests.
+	scopesChanged := true
+	if !req.State.Raw.IsNull() {
+		var state WorkspaceRoleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		scopesChanged = !plan.Scopes.Equal(state.Scopes)
+	}
+
+	if scopesChanged && len(scopes) > 0 {
+		resp.Diagnostics.Append(r.validateScopes(ctx, scopes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client, err := r.client.WorkspaceRoles(plan.AccountID.ValueUUID())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role", err))
+
+		return
+	}
+
+	resp.Diagnostics.Append(setEffectiveScopes(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_scopes"), plan.EffectiveScopes)...)
+}
+
+// validateScopes returns an error diagnostic for each scope that is not part
+// of the workspace scope catalogue. If the catalogue can't be retrieved,
+// validation is left to the API.
+func (r *WorkspaceRoleResource) validateScopes(ctx context.Context, scopes []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, err := r.client.WorkspaceScopes()
+	if err != nil {
+		diags.Append(helpers.CreateClientErrorDiagnostic("Workspace Scopes", err))
+
+		return diags
+	}
+
+	availableScopes, err := client.List(ctx)
+	if err != nil || len(availableScopes) == 0 {
+		tflog.Debug(ctx, "Skipping Workspace Role scope validation, unable to list workspace scopes", map[string]any{
+			"error": err,
+		})
+
+		return diags
+	}
+
+	names := make([]string, 0, len(availableScopes))
+	for _, scope := range availableScopes {
+		names = append(names, scope.Name)
+	}
+
+	for i, scope := range scopes {
+		if !slices.Contains(names, scope) {
+			diags.AddAttributeError(
+				path.Root("scopes").AtListIndex(i),
+				"Unknown Workspace Role scope",
+				fmt.Sprintf("The scope %q is not a valid workspace scope. Use the `prefect_workspace_scopes` data source to list the available scopes.", scope),
+			)
+		}
+	}
+
+	return diags
+}
+
 func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan WorkspaceRoleResourceModel
 
@@ -174,6 +355,15 @@ func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, timeoutDiags := plan.Timeouts.Create(ctx, r.client.GetDefaultTimeouts().Create)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var scopes []string
 	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
 	if resp.Diagnostics.HasError() {
@@ -204,6 +394,11 @@ func (r *WorkspaceRoleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	resp.Diagnostics.Append(setEffectiveScopes(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -220,6 +415,15 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, timeoutDiags := state.Timeouts.Read(ctx, r.client.GetDefaultTimeouts().Read)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkspaceRoles(state.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role", err))
@@ -255,6 +459,11 @@ func (r *WorkspaceRoleResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	resp.Diagnostics.Append(setEffectiveScopes(ctx, client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -270,6 +479,15 @@ func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, r.client.GetDefaultTimeouts().Update)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkspaceRoles(plan.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role", err))
@@ -314,6 +532,11 @@ func (r *WorkspaceRoleResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	resp.Diagnostics.Append(setEffectiveScopes(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -329,6 +552,15 @@ func (r *WorkspaceRoleResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, timeoutDiags := state.Timeouts.Delete(ctx, r.client.GetDefaultTimeouts().Delete)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helpers.ContextWithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	client, err := r.client.WorkspaceRoles(state.AccountID.ValueUUID())
 	if err != nil {
 		resp.Diagnostics.Append(helpers.CreateClientErrorDiagnostic("Workspace Role`", err))
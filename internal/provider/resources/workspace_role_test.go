@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -33,6 +34,28 @@ resource "prefect_workspace_role" "role" {
 }`, name, name)
 }
 
+func fixtureAccWorkspaceRoleResourceInherited(name string) string {
+	return fmt.Sprintf(`
+data "prefect_workspace_role" "viewer" {
+	name = "Viewer"
+}
+
+resource "prefect_workspace_role" "role" {
+	name = "%s"
+	description = "description for %s"
+	scopes = ["see_workers", "see_variables", "see_work_queues"]
+	inherited_role_id = data.prefect_workspace_role.viewer.id
+}`, name, name)
+}
+
+func fixtureAccWorkspaceRoleResourceInvalidScope(name string) string {
+	return fmt.Sprintf(`
+resource "prefect_workspace_role" "role" {
+	name = "%s"
+	scopes = ["see_workers", "see_wrokers"]
+}`, name)
+}
+
 //nolint:paralleltest // we use the resource.ParallelTest helper instead
 func TestAccResource_workspace_role(t *testing.T) {
 	// Workspace role is not supported in OSS.
@@ -60,6 +83,7 @@ func TestAccResource_workspace_role(t *testing.T) {
 					testutils.ExpectKnownValue(resourceName, "name", randomName),
 					testutils.ExpectKnownValue(resourceName, "description", fmt.Sprintf("%s description", randomName)),
 					testutils.ExpectKnownValueList(resourceName, "scopes", []string{"see_blocks", "see_artifacts"}),
+					testutils.ExpectKnownValueSet(resourceName, "effective_scopes", []string{"see_artifacts", "see_blocks"}),
 				},
 			},
 			{
@@ -73,14 +97,32 @@ func TestAccResource_workspace_role(t *testing.T) {
 					testutils.ExpectKnownValue(resourceName, "name", randomName),
 					testutils.ExpectKnownValue(resourceName, "description", fmt.Sprintf("description for %s", randomName)),
 					testutils.ExpectKnownValueList(resourceName, "scopes", []string{"see_workers", "see_variables", "see_work_queues"}),
+					testutils.ExpectKnownValueSet(resourceName, "effective_scopes", []string{"see_variables", "see_work_queues", "see_workers"}),
 				},
 			},
+			{
+				// Check that the effective scopes include the scopes of the inherited role
+				Config: fixtureAccWorkspaceRoleResourceInherited(randomName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					testutils.ExpectKnownValueList(resourceName, "scopes", []string{"see_workers", "see_variables", "see_work_queues"}),
+					testutils.ExpectKnownValueNotNull(resourceName, "inherited_role_id"),
+					testutils.CompareValuePairs(resourceName, "inherited_role_id", "data.prefect_workspace_role.viewer", "id"),
+				},
+			},
+			{
+				// Check that unknown scopes are rejected during plan
+				Config:      fixtureAccWorkspaceRoleResourceInvalidScope(randomName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The scope "see_wrokers" is not a valid workspace scope`),
+			},
 			// Import State checks - import by ID (default)
 			{
-				ImportState:             true,
-				ResourceName:            resourceName,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"scopes"},
+				ImportState:       true,
+				ResourceName:      resourceName,
+				ImportStateVerify: true,
+				// Scopes are not read back from the API (see copyWorkspaceRoleToModel),
+				// so the effective scopes can't be verified on import either.
+				ImportStateVerifyIgnore: []string{"scopes", "effective_scopes"},
 			},
 		},
 	})
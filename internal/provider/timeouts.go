@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// DefaultTimeoutsModel maps the provider's default_timeouts attribute to a Go type.
+type DefaultTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// ParseDefaultTimeouts converts the provider's default_timeouts attribute
+// into the timeouts passed to the client. Unset values are left as zero,
+// meaning no deadline is applied to the matching operation.
+func ParseDefaultTimeouts(ctx context.Context, value types.Object) (api.Timeouts, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var timeouts api.Timeouts
+
+	if value.IsNull() || value.IsUnknown() {
+		return timeouts, diags
+	}
+
+	var model DefaultTimeoutsModel
+	diags.Append(value.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return timeouts, diags
+	}
+
+	for name, field := range map[string]struct {
+		value  types.String
+		target *time.Duration
+	}{
+		"create": {model.Create, &timeouts.Create},
+		"read":   {model.Read, &timeouts.Read},
+		"update": {model.Update, &timeouts.Update},
+		"delete": {model.Delete, &timeouts.Delete},
+	} {
+		if field.value.IsNull() || field.value.IsUnknown() {
+			continue
+		}
+
+		duration, err := time.ParseDuration(field.value.ValueString())
+		if err != nil || duration <= 0 {
+			diags.AddAttributeError(
+				path.Root("default_timeouts").AtName(name),
+				"Invalid Default Timeout",
+				fmt.Sprintf("The default %s timeout %q must be a positive duration, such as \"30s\" or \"10m\".", name, field.value.ValueString()),
+			)
+
+			continue
+		}
+
+		*field.target = duration
+	}
+
+	return timeouts, diags
+}
@@ -23,4 +23,6 @@ type PrefectProviderModel struct {
 	WorkspaceID   customtypes.UUIDValue `tfsdk:"workspace_id"`
 	Profile       types.String          `tfsdk:"profile"`
 	ProfileFile   types.String          `tfsdk:"profile_file"`
+
+	DefaultTimeouts types.Object `tfsdk:"default_timeouts"`
 }
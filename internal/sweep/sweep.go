@@ -152,6 +152,49 @@ func AddWorkspaceRoleSweeper() {
 	})
 }
 
+// AddAccountRoleSweeper adds a sweeper that deletes any custom account roles that match
+// the prefix we use for ephemeral account roles in acceptance tests.
+//
+// This is designed to run at a given interval when other acceptance tests are
+// not likely running.
+func AddAccountRoleSweeper() {
+	resource.AddTestSweepers("AccountRoles", &resource.Sweeper{
+		Name: "accountRoles",
+		F: func(_ string) error {
+			client, err := testutils.NewTestClient()
+			if err != nil {
+				return fmt.Errorf("unable to get prefect client: %w", err)
+			}
+
+			// NOTE: the accountID is inherited by the one set in the test environment
+			accountRolesClient, err := client.AccountRoles(uuid.Nil)
+			if err != nil {
+				return fmt.Errorf("unable to get account roles client: %w", err)
+			}
+
+			accountRoles, err := accountRolesClient.List(context.Background(), nil)
+			if err != nil {
+				return fmt.Errorf("unable to list account roles: %w", err)
+			}
+
+			for _, accountRole := range accountRoles {
+				if !accountRole.IsSystemRole && strings.HasPrefix(accountRole.Name, testutils.TestAccPrefix) {
+					log.Printf("found acceptance testing account role %s, deleting...\n", accountRole.Name)
+
+					err := accountRolesClient.Delete(context.Background(), accountRole.ID)
+					if err != nil {
+						log.Printf("unable to delete account role %s during sweep: %s\n", accountRole.Name, err)
+					}
+				} else {
+					log.Printf("account role %s does not match acceptance testing prefix, skipping...\n", accountRole.Name)
+				}
+			}
+
+			return nil
+		},
+	})
+}
+
 // AddTeamSweeper adds a sweeper that deletes any teams that match
 // the prefix we use for ephemeral teams in acceptance tests.
 //
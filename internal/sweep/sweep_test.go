@@ -12,6 +12,7 @@ func TestMain(m *testing.M) {
 	sweep.AddWorkspaceSweeper()
 	sweep.AddServiceAccountSweeper()
 	sweep.AddWorkspaceRoleSweeper()
+	sweep.AddAccountRoleSweeper()
 	sweep.AddTeamSweeper()
 
 	resource.TestMain(m)
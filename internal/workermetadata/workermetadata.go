@@ -0,0 +1,41 @@
+// Package workermetadata provides a snapshot of the worker metadata served by
+// the Prefect API, embedded in the provider so that it can be used without
+// access to the `/collections/views/aggregate-worker-metadata` endpoint.
+package workermetadata
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/api"
+)
+
+// The snapshot is refreshed with `scripts/update-worker-metadata`.
+//
+//go:embed worker_metadata.json
+var snapshotJSON []byte
+
+// Snapshot is the worker metadata embedded in the provider.
+type Snapshot struct {
+	// PrefectVersion is the version of Prefect the snapshot was taken from.
+	PrefectVersion string `json:"prefect_version"`
+
+	WorkerMetadata api.WorkerTypeByPackage `json:"worker_metadata"`
+}
+
+var loadSnapshot = sync.OnceValues(func() (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := json.Unmarshal(snapshotJSON, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded worker metadata: %w", err)
+	}
+
+	return &snapshot, nil
+})
+
+// Embedded returns the worker metadata embedded in the provider.
+// The returned snapshot is shared, and must not be modified.
+func Embedded() (*Snapshot, error) {
+	return loadSnapshot()
+}
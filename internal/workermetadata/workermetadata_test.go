@@ -0,0 +1,71 @@
+package workermetadata_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/prefecthq/terraform-provider-prefect/internal/workermetadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var placeholderRegex = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+func TestEmbedded(t *testing.T) {
+	t.Parallel()
+
+	snapshot, err := workermetadata.Embedded()
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, snapshot.PrefectVersion)
+
+	workerTypes := map[string]json.RawMessage{}
+	for _, metadataByWorkerType := range snapshot.WorkerMetadata {
+		for workerType, metadata := range metadataByWorkerType {
+			assert.Equal(t, workerType, metadata.Type)
+			workerTypes[workerType] = metadata.DefaultBaseJobConfiguration
+		}
+	}
+
+	// These are the worker types exposed by the prefect_worker_metadata data source.
+	expected := []string{
+		"kubernetes",
+		"ecs",
+		"azure-container-instance",
+		"docker",
+		"cloud-run",
+		"cloud-run-v2",
+		"vertex-ai",
+		"prefect-agent",
+		"process",
+		"azure-container-instance:push",
+		"cloud-run:push",
+		"cloud-run-v2:push",
+		"ecs:push",
+		"modal:push",
+		"prefect:managed",
+	}
+
+	for _, workerType := range expected {
+		t.Run(workerType, func(t *testing.T) {
+			t.Parallel()
+
+			config, ok := workerTypes[workerType]
+			require.True(t, ok, "worker type %q is missing from the snapshot", workerType)
+
+			var template struct {
+				JobConfiguration json.RawMessage `json:"job_configuration"`
+				Variables        struct {
+					Properties map[string]any `json:"properties"`
+				} `json:"variables"`
+			}
+			require.NoError(t, json.Unmarshal(config, &template))
+
+			// Every placeholder in the job configuration must reference a variable.
+			for _, match := range placeholderRegex.FindAllStringSubmatch(string(template.JobConfiguration), -1) {
+				assert.Contains(t, template.Variables.Properties, match[1])
+			}
+		})
+	}
+}
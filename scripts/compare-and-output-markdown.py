@@ -26,7 +26,6 @@ apis_we_wont_implement = [
     "event",
     "flow_run_state",
     "flow_run",
-    "invitation",
     "log",
     "me",
     "metric",
@@ -39,7 +38,6 @@ apis_we_wont_implement = [
     "task_worker",
     "ui",
     "workspace_invitation",
-    "workspace_scope",
 ]
 
 # Update this dict to whitelist an API resource
@@ -50,6 +48,7 @@ apis_we_wont_implement = [
 known_aliases = {
     "account_membership": ["account_member"],
     "account_role": ["account_member"],
+    "invitation": ["account_invitation"],
     "block_document": ["block"],
     "block_type": ["block"],
     "bot": ["service_account"],
@@ -31,6 +31,7 @@ var relations = map[string][]string{
 	"deployments":               {"deployment"},
 	"events":                    {},
 	"flows":                     {"flow"},
+	"invitations":               {"account_invitation"},
 	"slas":                      {},
 	"team_access":               {"workspace_access"},
 	"user_access":               {"workspace_access"},
@@ -50,7 +51,6 @@ var ignoredFields = []string{
 	"flow_run_states",
 	"health",
 	"hello",
-	"invitations",
 	"lenses",
 	"logs",
 	"managed_execution",